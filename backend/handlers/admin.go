@@ -0,0 +1,68 @@
+package handlers
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"go-movie-explorer/middleware"
+	"go-movie-explorer/services"
+)
+
+// CacheAdminHandler はレスポンスキャッシュの管理用ハンドラー /admin/cache
+type CacheAdminHandler struct {
+	cache *services.ResponseCache
+	token string // X-Admin-Tokenヘッダーで要求する管理用トークン
+}
+
+// NewCacheAdminHandler はキャッシュ管理用ハンドラーを作成
+func NewCacheAdminHandler(cache *services.ResponseCache, token string) *CacheAdminHandler {
+	return &CacheAdminHandler{cache: cache, token: token}
+}
+
+// cachePurgeResponse はキャッシュ削除APIのレスポンス
+type cachePurgeResponse struct {
+	Deleted int                 `json:"deleted"`
+	Stats   services.CacheStats `json:"stats"`
+}
+
+// ServeCache はキャッシュの統計取得（GET）と削除（DELETE）を行う
+// DELETEは ?key= で単一キー、?prefix= で前方一致、指定なしで全件を削除する
+func (h *CacheAdminHandler) ServeCache(w http.ResponseWriter, r *http.Request) error {
+	if h.token == "" || subtle.ConstantTimeCompare([]byte(r.Header.Get("X-Admin-Token")), []byte(h.token)) != 1 {
+		return middleware.NewAPIError(http.StatusUnauthorized, "管理用トークンが不正です")
+	}
+	if h.cache == nil {
+		return middleware.NewNotFoundError("キャッシュが無効化されています")
+	}
+
+	var response interface{}
+	switch r.Method {
+	case http.MethodGet:
+		response = h.cache.Stats()
+	case http.MethodDelete:
+		query := r.URL.Query()
+		deleted := 0
+		switch {
+		case query.Get("key") != "":
+			if h.cache.Delete(query.Get("key")) {
+				deleted = 1
+			}
+		case query.Get("prefix") != "":
+			deleted = h.cache.DeletePrefix(query.Get("prefix"))
+		default:
+			deleted = h.cache.Purge()
+		}
+		response = cachePurgeResponse{Deleted: deleted, Stats: h.cache.Stats()}
+	default:
+		return middleware.NewAPIError(http.StatusMethodNotAllowed, fmt.Sprintf("許可されていないメソッドです: %s", r.Method))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		return middleware.NewInternalServerError(fmt.Sprintf("JSONレスポンスのエンコードに失敗しました: %v", err))
+	}
+	return nil
+}
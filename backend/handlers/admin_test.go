@@ -0,0 +1,74 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"go-movie-explorer/middleware"
+	"go-movie-explorer/services"
+)
+
+// TestCacheAdminHandler - キャッシュ管理用ハンドラーのテスト
+func TestCacheAdminHandler(t *testing.T) {
+	cache := services.NewResponseCache(10, 0)
+	cache.Set("/movie/1", []byte("1"), time.Hour)
+	cache.Set("/movie/2", []byte("2"), time.Hour)
+	cache.Set("/genre/movie/list", []byte("3"), time.Hour)
+	h := NewCacheAdminHandler(cache, "secret")
+
+	t.Run("トークンなしは401", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/admin/cache", nil)
+		err := h.ServeCache(httptest.NewRecorder(), req)
+		apiErr, ok := err.(*middleware.APIError)
+		if !ok || apiErr.StatusCode != http.StatusUnauthorized {
+			t.Errorf("Expected 401 APIError, got %v", err)
+		}
+	})
+
+	t.Run("統計情報の取得", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/admin/cache", nil)
+		req.Header.Set("X-Admin-Token", "secret")
+		rec := httptest.NewRecorder()
+		if err := h.ServeCache(rec, req); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		var stats services.CacheStats
+		if err := json.NewDecoder(rec.Body).Decode(&stats); err != nil {
+			t.Fatalf("Failed to decode JSON response: %v", err)
+		}
+		if stats.Entries != 3 {
+			t.Errorf("Expected 3 entries, got %d", stats.Entries)
+		}
+	})
+
+	t.Run("前方一致で削除", func(t *testing.T) {
+		req := httptest.NewRequest("DELETE", "/admin/cache?prefix=/movie/", nil)
+		req.Header.Set("X-Admin-Token", "secret")
+		rec := httptest.NewRecorder()
+		if err := h.ServeCache(rec, req); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		var response cachePurgeResponse
+		if err := json.NewDecoder(rec.Body).Decode(&response); err != nil {
+			t.Fatalf("Failed to decode JSON response: %v", err)
+		}
+		if response.Deleted != 2 || response.Stats.Entries != 1 {
+			t.Errorf("Unexpected response: %+v", response)
+		}
+	})
+
+	t.Run("許可されていないメソッドは405", func(t *testing.T) {
+		req := httptest.NewRequest("POST", "/admin/cache", nil)
+		req.Header.Set("X-Admin-Token", "secret")
+		err := h.ServeCache(httptest.NewRecorder(), req)
+		apiErr, ok := err.(*middleware.APIError)
+		if !ok || apiErr.StatusCode != http.StatusMethodNotAllowed {
+			t.Errorf("Expected 405 APIError, got %v", err)
+		}
+	})
+}
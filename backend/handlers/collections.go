@@ -0,0 +1,19 @@
+package handlers
+
+import (
+	"net/http"
+)
+
+// コレクション（シリーズ作品）取得ハンドラー /api/collection/{id}
+func (h *MovieHandler) CollectionHandler(w http.ResponseWriter, r *http.Request) error {
+	collectionID, err := pathID(r, "id", "コレクション")
+	if err != nil {
+		return err
+	}
+
+	collection, err := h.provider.GetCollection(r.Context(), collectionID)
+	if err != nil {
+		return upstreamError(err, "コレクション取得失敗")
+	}
+	return writeJSON(w, collection)
+}
@@ -0,0 +1,123 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"go-movie-explorer/middleware"
+	"go-movie-explorer/models"
+	"go-movie-explorer/services"
+)
+
+// TestCollectionHandler - コレクション取得ハンドラーのテスト
+func TestCollectionHandler(t *testing.T) {
+	t.Run("コレクションを返す", func(t *testing.T) {
+		provider := &fakeMovieProvider{}
+		h := NewMovieHandler(provider)
+		req := httptest.NewRequest("GET", "/api/collection/119", nil)
+		req.SetPathValue("id", "119")
+		rec := httptest.NewRecorder()
+
+		if err := h.CollectionHandler(rec, req); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		var collection models.Collection
+		if err := json.NewDecoder(rec.Body).Decode(&collection); err != nil {
+			t.Fatalf("Failed to decode response: %v", err)
+		}
+		if provider.gotID != 119 || len(collection.Parts) != 2 {
+			t.Errorf("Unexpected collection: %+v", collection)
+		}
+	})
+
+	t.Run("無効なIDは400", func(t *testing.T) {
+		provider := &fakeMovieProvider{}
+		h := NewMovieHandler(provider)
+		req := httptest.NewRequest("GET", "/api/collection/abc", nil)
+		req.SetPathValue("id", "abc")
+
+		err := h.CollectionHandler(httptest.NewRecorder(), req)
+		apiErr, ok := err.(*middleware.APIError)
+		if !ok || apiErr.StatusCode != http.StatusBadRequest {
+			t.Errorf("Expected 400 APIError, got %v", err)
+		}
+		if provider.callCount != 0 {
+			t.Errorf("Expected provider not to be called, got %d calls", provider.callCount)
+		}
+	})
+}
+
+// TestMovieDetailHandler_IncludeCollection - include=collectionでシリーズ中の位置を含めることのテスト
+func TestMovieDetailHandler_IncludeCollection(t *testing.T) {
+	decode := func(t *testing.T, rec *httptest.ResponseRecorder) *models.MovieCollection {
+		var detail models.MovieDetail
+		if err := json.NewDecoder(rec.Body).Decode(&detail); err != nil {
+			t.Fatalf("Failed to decode response: %v", err)
+		}
+		return detail.BelongsToCollection
+	}
+
+	t.Run("include=collectionの場合はシリーズ中の位置を含める", func(t *testing.T) {
+		provider := &fakeMovieProvider{collectionID: 119}
+		h := NewMovieHandler(provider)
+		rec := httptest.NewRecorder()
+
+		if err := h.MovieDetailHandler(rec, httptest.NewRequest("GET", "/api/movie/2?include=collection", nil)); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if collection := decode(t, rec); collection == nil || collection.ID != 119 || collection.Part != 2 || collection.TotalParts != 2 {
+			t.Errorf("Expected part 2 of 2 in collection 119, got %+v", collection)
+		}
+	})
+
+	t.Run("指定しない場合はコレクションを取得しない", func(t *testing.T) {
+		provider := &fakeMovieProvider{collectionID: 119, collectionErr: errors.New("should not be called")}
+		h := NewMovieHandler(provider)
+		rec := httptest.NewRecorder()
+
+		if err := h.MovieDetailHandler(rec, httptest.NewRequest("GET", "/api/movie/2", nil)); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if collection := decode(t, rec); collection == nil || collection.ID != 119 || collection.Part != 0 {
+			t.Errorf("Expected collection without part, got %+v", collection)
+		}
+	})
+
+	// シリーズ中の位置は付加情報のため、コレクションが存在しない・TMDB障害でも詳細は返す
+	for name, collectionErr := range map[string]error{
+		"コレクションが404":   &services.UpstreamError{Kind: services.UpstreamNotFound, StatusCode: 404},
+		"コレクション取得が503": &services.UpstreamError{Kind: services.UpstreamUnavailable, StatusCode: 503},
+	} {
+		t.Run(name+"の場合は位置なしで詳細を返す", func(t *testing.T) {
+			h := NewMovieHandler(&fakeMovieProvider{collectionID: 119, collectionErr: collectionErr})
+			rec := httptest.NewRecorder()
+
+			if err := h.MovieDetailHandler(rec, httptest.NewRequest("GET", "/api/movie/2?include=credits,collection", nil)); err != nil {
+				t.Fatalf("Expected movie detail despite collection error, got %v", err)
+			}
+			if rec.Code != http.StatusOK {
+				t.Errorf("Expected status 200, got %d", rec.Code)
+			}
+			if collection := decode(t, rec); collection == nil || collection.ID != 119 || collection.Part != 0 || collection.TotalParts != 0 {
+				t.Errorf("Expected collection without part, got %+v", collection)
+			}
+		})
+	}
+
+	t.Run("クライアントが切断した場合はエラー", func(t *testing.T) {
+		h := NewMovieHandler(&fakeMovieProvider{collectionID: 119, collectionErr: fmt.Errorf("%w: %w", services.ErrRequestCanceled, context.Canceled)})
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		err := h.MovieDetailHandler(httptest.NewRecorder(), httptest.NewRequest("GET", "/api/movie/2?include=collection", nil).WithContext(ctx))
+		apiErr, ok := err.(*middleware.APIError)
+		if !ok || apiErr.StatusCode != middleware.StatusClientClosedRequest {
+			t.Errorf("Expected 499 APIError, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,19 @@
+package handlers
+
+import (
+	"net/http"
+)
+
+// 出演者・スタッフ取得ハンドラー /api/movie/{id}/credits
+func (h *MovieHandler) MovieCreditsHandler(w http.ResponseWriter, r *http.Request) error {
+	movieID, err := pathID(r, "id", "映画")
+	if err != nil {
+		return err
+	}
+
+	credits, err := h.provider.GetMovieCredits(r.Context(), movieID)
+	if err != nil {
+		return upstreamError(err, "出演者・スタッフ取得失敗")
+	}
+	return writeJSON(w, credits)
+}
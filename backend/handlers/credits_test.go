@@ -0,0 +1,126 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"go-movie-explorer/middleware"
+	"go-movie-explorer/models"
+)
+
+// TestMovieCreditsHandler - 出演者・スタッフ取得ハンドラーのテスト
+func TestMovieCreditsHandler(t *testing.T) {
+	t.Run("映画IDをプロバイダーに渡す", func(t *testing.T) {
+		provider := &fakeMovieProvider{}
+		h := NewMovieHandler(provider)
+		req := httptest.NewRequest("GET", "/api/movie/550/credits", nil)
+		req.SetPathValue("id", "550")
+		rec := httptest.NewRecorder()
+
+		if err := h.MovieCreditsHandler(rec, req); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if provider.gotID != 550 {
+			t.Errorf("Expected id 550, got %d", provider.gotID)
+		}
+
+		var credits models.Credits
+		if err := json.NewDecoder(rec.Body).Decode(&credits); err != nil {
+			t.Fatalf("Failed to decode response: %v", err)
+		}
+		if len(credits.Cast) != 1 || len(credits.Directors) != 1 || len(credits.Crew["Directing"]) != 1 {
+			t.Errorf("Unexpected credits: %+v", credits)
+		}
+	})
+
+	t.Run("無効な映画IDは400を返す", func(t *testing.T) {
+		provider := &fakeMovieProvider{}
+		h := NewMovieHandler(provider)
+		req := httptest.NewRequest("GET", "/api/movie/abc/credits", nil)
+		req.SetPathValue("id", "abc")
+
+		err := h.MovieCreditsHandler(httptest.NewRecorder(), req)
+		apiErr, ok := err.(*middleware.APIError)
+		if !ok || apiErr.StatusCode != http.StatusBadRequest {
+			t.Errorf("Expected 400 APIError, got %v", err)
+		}
+		if provider.callCount != 0 {
+			t.Errorf("Expected provider not to be called, got %d calls", provider.callCount)
+		}
+	})
+}
+
+// TestMovieDetailHandler_IncludeCredits - include=creditsで詳細に出演者・スタッフを含めることのテスト
+func TestMovieDetailHandler_IncludeCredits(t *testing.T) {
+	t.Run("include=creditsの場合は出演者・スタッフを含める", func(t *testing.T) {
+		provider := &fakeMovieProvider{}
+		h := NewMovieHandler(provider)
+		rec := httptest.NewRecorder()
+
+		if err := h.MovieDetailHandler(rec, httptest.NewRequest("GET", "/api/movie/550?include=credits", nil)); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		var detail models.MovieDetail
+		if err := json.NewDecoder(rec.Body).Decode(&detail); err != nil {
+			t.Fatalf("Failed to decode response: %v", err)
+		}
+		if detail.Credits == nil || detail.Credits.Directors[0].Name != "Fake Director" {
+			t.Errorf("Expected credits in detail, got %+v", detail.Credits)
+		}
+	})
+
+	t.Run("指定しない場合は含めない", func(t *testing.T) {
+		provider := &fakeMovieProvider{}
+		h := NewMovieHandler(provider)
+		rec := httptest.NewRecorder()
+
+		if err := h.MovieDetailHandler(rec, httptest.NewRequest("GET", "/api/movie/550", nil)); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		var raw map[string]interface{}
+		if err := json.NewDecoder(rec.Body).Decode(&raw); err != nil {
+			t.Fatalf("Failed to decode response: %v", err)
+		}
+		// 詳細と予告編の2回のみ（出演者・スタッフは取得しない）
+		if _, ok := raw["credits"]; ok || provider.callCount != 2 {
+			t.Errorf("Expected no credits (calls=%d), got %v", provider.callCount, raw["credits"])
+		}
+	})
+
+	t.Run("不明なincludeは400を返す", func(t *testing.T) {
+		h := NewMovieHandler(&fakeMovieProvider{})
+		err := h.MovieDetailHandler(httptest.NewRecorder(), httptest.NewRequest("GET", "/api/movie/550?include=unknown", nil))
+		apiErr, ok := err.(*middleware.APIError)
+		if !ok || apiErr.StatusCode != http.StatusBadRequest {
+			t.Errorf("Expected 400 APIError, got %v", err)
+		}
+	})
+}
+
+// TestMovieDetailHandler_Concurrent - 詳細・出演者・動画を並行して取得することのテスト
+func TestMovieDetailHandler_Concurrent(t *testing.T) {
+	provider := &fakeMovieProvider{concurrent: &sync.WaitGroup{}}
+	provider.concurrent.Add(3)
+	h := NewMovieHandler(provider)
+
+	// 順番に取得すると最初の呼び出しが他の呼び出しを待ち続けて終わらない
+	done := make(chan error, 1)
+	go func() {
+		done <- h.MovieDetailHandler(httptest.NewRecorder(), httptest.NewRequest("GET", "/api/movie/550?include=credits", nil))
+	}()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Expected detail, credits and videos to be fetched concurrently")
+	}
+	if provider.callCount != 3 {
+		t.Errorf("Expected 3 calls, got %d", provider.callCount)
+	}
+}
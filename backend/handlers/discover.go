@@ -0,0 +1,206 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"go-movie-explorer/middleware"
+	"go-movie-explorer/services"
+)
+
+// maxFilterIDs はジャンル・キーワード・制作会社・配信サービスに指定できるIDの最大数
+const maxFilterIDs = 20
+
+// monetizationTypes はmonetizationに指定できる視聴方法
+var monetizationTypes = []string{"flatrate", "rent", "buy", "free", "ads"}
+
+// parseDiscoverOptions は映画一覧（/api/movies）の絞り込みパラメータを検証して変換
+//
+//	sort_by=vote_average.desc
+//	year=1999 または release_date_from=2020-01-01&release_date_to=2020-12-31
+//	vote_average_min=7.5&vote_count_min=100&runtime_min=90&runtime_max=150
+//	original_language=ja
+//	genre_id=28,12（全て含む） / genre_id=28|35（いずれかを含む）&exclude_genre=27
+//	keyword_id=4379&company_id=420
+//	watch_provider=8,119（全てで視聴可能） / watch_provider=8|119（いずれかで視聴可能）&monetization=flatrate
+func parseDiscoverOptions(r *http.Request) (services.DiscoverOptions, error) {
+	query := r.URL.Query()
+	var opts services.DiscoverOptions
+	var err error
+
+	if sortBy := query.Get("sort_by"); sortBy != "" {
+		if !slices.Contains(services.DiscoverSorts, sortBy) {
+			return opts, middleware.NewBadRequestError(fmt.Sprintf("無効なsort_byです: %s（指定可能: %s）", sortBy, strings.Join(services.DiscoverSorts, ", ")))
+		}
+		opts.SortBy = sortBy
+	}
+
+	// 公開年・公開日
+	if opts.Year, err = intQuery(query, "year", 1800, 2100); err != nil {
+		return opts, err
+	}
+	if opts.ReleaseDateFrom, err = dateQuery(query, "release_date_from"); err != nil {
+		return opts, err
+	}
+	if opts.ReleaseDateTo, err = dateQuery(query, "release_date_to"); err != nil {
+		return opts, err
+	}
+	if opts.Year > 0 && (opts.ReleaseDateFrom != "" || opts.ReleaseDateTo != "") {
+		return opts, middleware.NewBadRequestError("yearとrelease_date_from・release_date_toは同時に指定できません")
+	}
+	if opts.ReleaseDateFrom != "" && opts.ReleaseDateTo != "" && opts.ReleaseDateFrom > opts.ReleaseDateTo {
+		return opts, middleware.NewBadRequestError("release_date_fromはrelease_date_to以前の日付を指定してください")
+	}
+
+	// 評価・上映時間
+	if value := query.Get("vote_average_min"); value != "" {
+		opts.MinVoteAverage, err = strconv.ParseFloat(value, 64)
+		if err != nil || opts.MinVoteAverage < 0 || opts.MinVoteAverage > 10 {
+			return opts, middleware.NewBadRequestError(fmt.Sprintf("vote_average_minは0〜10の数値で指定してください: %s", value))
+		}
+	}
+	if opts.MinVoteCount, err = intQuery(query, "vote_count_min", 0, 1000000); err != nil {
+		return opts, err
+	}
+	if opts.MinRuntime, err = intQuery(query, "runtime_min", 0, 1000); err != nil {
+		return opts, err
+	}
+	if opts.MaxRuntime, err = intQuery(query, "runtime_max", 0, 1000); err != nil {
+		return opts, err
+	}
+	if opts.MinRuntime > 0 && opts.MaxRuntime > 0 && opts.MinRuntime > opts.MaxRuntime {
+		return opts, middleware.NewBadRequestError("runtime_minはruntime_max以下で指定してください")
+	}
+
+	if value := query.Get("original_language"); value != "" {
+		lang, ok := services.ParseLanguage(value)
+		if !ok || strings.Contains(lang, "-") {
+			return opts, middleware.NewBadRequestError(fmt.Sprintf("original_languageはISO 639-1の2文字で指定してください（例: ja）: %s", value))
+		}
+		opts.OriginalLanguage = lang
+	}
+
+	// ジャンル・キーワード・制作会社・配信サービス
+	if opts.Genres, opts.ExcludeGenres, err = genreQuery(query); err != nil {
+		return opts, err
+	}
+	if opts.Keywords, err = idFilterQuery(query, "keyword_id"); err != nil {
+		return opts, err
+	}
+	if opts.Companies, err = idFilterQuery(query, "company_id"); err != nil {
+		return opts, err
+	}
+
+	if opts.WatchProviders, err = idFilterQuery(query, "watch_provider"); err != nil {
+		return opts, err
+	}
+	if value := query.Get("monetization"); value != "" {
+		if len(opts.WatchProviders.IDs) == 0 {
+			return opts, middleware.NewBadRequestError("monetizationはwatch_providerと合わせて指定してください")
+		}
+		for _, t := range strings.Split(value, ",") {
+			t = strings.TrimSpace(t)
+			if !slices.Contains(monetizationTypes, t) {
+				return opts, middleware.NewBadRequestError(fmt.Sprintf("無効なmonetizationです: %s（指定可能: %s）", t, strings.Join(monetizationTypes, ", ")))
+			}
+			opts.MonetizationTypes = append(opts.MonetizationTypes, t)
+		}
+	}
+	return opts, nil
+}
+
+// genreQuery はgenre_id（28,12 / 28|35）とexclude_genre（カンマ区切り）を取得
+func genreQuery(query url.Values) (services.IDFilter, []int, error) {
+	genres, err := idFilterQuery(query, "genre_id")
+	if err != nil {
+		return services.IDFilter{}, nil, err
+	}
+	exclude, err := idListQuery(query, "exclude_genre")
+	if err != nil {
+		return services.IDFilter{}, nil, err
+	}
+	for _, id := range exclude {
+		if slices.Contains(genres.IDs, id) {
+			return services.IDFilter{}, nil, middleware.NewBadRequestError(fmt.Sprintf("genre_idとexclude_genreに同じジャンルが指定されています: %d", id))
+		}
+	}
+	return genres, exclude, nil
+}
+
+// intQuery は整数のクエリパラメータをmin〜maxの範囲で取得（未指定の場合は0）
+func intQuery(query url.Values, name string, min, max int) (int, error) {
+	value := query.Get(name)
+	if value == "" {
+		return 0, nil
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil || n < min || n > max {
+		return 0, middleware.NewBadRequestError(fmt.Sprintf("%sは%d〜%dの整数で指定してください: %s", name, min, max, value))
+	}
+	return n, nil
+}
+
+// dateQuery はYYYY-MM-DD形式の日付のクエリパラメータを取得（未指定の場合は空文字）
+func dateQuery(query url.Values, name string) (string, error) {
+	value := query.Get(name)
+	if value == "" {
+		return "", nil
+	}
+	if _, err := time.Parse(time.DateOnly, value); err != nil {
+		return "", middleware.NewBadRequestError(fmt.Sprintf("%sはYYYY-MM-DD形式で指定してください: %s", name, value))
+	}
+	return value, nil
+}
+
+// idFilterQuery はAND/OR指定付きのIDリストを取得
+// 28,12は全てを含む、28|12はいずれかを含む（混在は不可）
+func idFilterQuery(query url.Values, name string) (services.IDFilter, error) {
+	value := query.Get(name)
+	if value == "" {
+		return services.IDFilter{}, nil
+	}
+	if strings.Contains(value, ",") && strings.Contains(value, "|") {
+		return services.IDFilter{}, middleware.NewBadRequestError(fmt.Sprintf("%sには「,」（全て含む）と「|」（いずれかを含む）を混在できません: %s", name, value))
+	}
+	filter := services.IDFilter{Any: strings.Contains(value, "|")}
+	sep := ","
+	if filter.Any {
+		sep = "|"
+	}
+	ids, err := parseIDs(name, strings.Split(value, sep))
+	if err != nil {
+		return services.IDFilter{}, err
+	}
+	filter.IDs = ids
+	return filter, nil
+}
+
+// idListQuery はカンマ区切りのIDリストを取得
+func idListQuery(query url.Values, name string) ([]int, error) {
+	value := query.Get(name)
+	if value == "" {
+		return nil, nil
+	}
+	return parseIDs(name, strings.Split(value, ","))
+}
+
+// parseIDs はIDの文字列を正の整数に変換（最大maxFilterIDs件）
+func parseIDs(name string, values []string) ([]int, error) {
+	if len(values) == 0 || len(values) > maxFilterIDs {
+		return nil, middleware.NewBadRequestError(fmt.Sprintf("%sは1〜%d件で指定してください", name, maxFilterIDs))
+	}
+	ids := make([]int, 0, len(values))
+	for _, s := range values {
+		id, err := strconv.Atoi(strings.TrimSpace(s))
+		if err != nil || id < 1 {
+			return nil, middleware.NewBadRequestError(fmt.Sprintf("%sに無効なIDが含まれています: %s", name, s))
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
@@ -0,0 +1,95 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"go-movie-explorer/middleware"
+)
+
+// TestMoviesHandler_DiscoverFilters - 映画一覧の絞り込みパラメータの変換のテスト
+func TestMoviesHandler_DiscoverFilters(t *testing.T) {
+	provider := &fakeMovieProvider{}
+	h := NewMovieHandler(provider)
+
+	query := "sort_by=vote_average.desc&release_date_from=2020-01-01&release_date_to=2020-12-31" +
+		"&vote_average_min=7.5&vote_count_min=100&runtime_min=90&runtime_max=150&original_language=JA" +
+		"&genre_id=28|35&exclude_genre=27,53&keyword_id=4379,9882&company_id=420"
+	if err := h.MoviesHandler(httptest.NewRecorder(), httptest.NewRequest("GET", "/api/movies?"+query, nil)); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	opts := provider.gotDiscover
+	if opts.SortBy != "vote_average.desc" || opts.ReleaseDateFrom != "2020-01-01" || opts.ReleaseDateTo != "2020-12-31" {
+		t.Errorf("Unexpected sort or date range: %+v", opts)
+	}
+	if opts.MinVoteAverage != 7.5 || opts.MinVoteCount != 100 || opts.MinRuntime != 90 || opts.MaxRuntime != 150 {
+		t.Errorf("Unexpected vote or runtime filters: %+v", opts)
+	}
+	if opts.OriginalLanguage != "ja" {
+		t.Errorf("Expected original language to be normalized to ja, got %q", opts.OriginalLanguage)
+	}
+	if !opts.Genres.Any || !slices.Equal(opts.Genres.IDs, []int{28, 35}) || !slices.Equal(opts.ExcludeGenres, []int{27, 53}) {
+		t.Errorf("Unexpected genre filters: %+v / %v", opts.Genres, opts.ExcludeGenres)
+	}
+	if opts.Keywords.Any || !slices.Equal(opts.Keywords.IDs, []int{4379, 9882}) || !slices.Equal(opts.Companies.IDs, []int{420}) {
+		t.Errorf("Unexpected keyword or company filters: %+v / %+v", opts.Keywords, opts.Companies)
+	}
+}
+
+// TestMoviesHandler_InvalidDiscoverFilters - 不正な絞り込みパラメータを400で拒否することのテスト
+func TestMoviesHandler_InvalidDiscoverFilters(t *testing.T) {
+	invalid := []string{
+		"sort_by=rating",
+		"year=99",
+		"year=2020&release_date_from=2020-01-01",
+		"release_date_from=2020/01/01",
+		"release_date_from=2021-01-01&release_date_to=2020-01-01",
+		"vote_average_min=11",
+		"vote_average_min=high",
+		"vote_count_min=-1",
+		"runtime_min=150&runtime_max=90",
+		"original_language=ja-JP",
+		"original_language=japanese",
+		"genre_id=28,12|35",
+		"genre_id=action",
+		"genre_id=28,",
+		"genre_id=28&exclude_genre=28",
+		"keyword_id=0",
+		"company_id=1,2,3,4,5,6,7,8,9,10,11,12,13,14,15,16,17,18,19,20,21",
+	}
+	for _, query := range invalid {
+		t.Run(query, func(t *testing.T) {
+			provider := &fakeMovieProvider{}
+			h := NewMovieHandler(provider)
+
+			err := h.MoviesHandler(httptest.NewRecorder(), httptest.NewRequest("GET", "/api/movies?"+query, nil))
+			apiErr, ok := err.(*middleware.APIError)
+			if !ok || apiErr.StatusCode != http.StatusBadRequest {
+				t.Errorf("Expected 400 APIError, got %v", err)
+			}
+			if provider.callCount != 0 {
+				t.Errorf("Expected provider not to be called, got %d calls", provider.callCount)
+			}
+		})
+	}
+}
+
+// TestMoviesHandler_NoFilters - 絞り込みパラメータが無い場合は条件なしで取得することのテスト
+func TestMoviesHandler_NoFilters(t *testing.T) {
+	provider := &fakeMovieProvider{}
+	h := NewMovieHandler(provider)
+
+	if err := h.MoviesHandler(httptest.NewRecorder(), httptest.NewRequest("GET", "/api/movies?page=2", nil)); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	opts := provider.gotDiscover
+	if opts.SortBy != "" || opts.Year != 0 || opts.Genres.IDs != nil || opts.WatchProviders.IDs != nil {
+		t.Errorf("Expected empty discover options, got %+v", opts)
+	}
+	if provider.gotPage != 2 {
+		t.Errorf("Expected page 2, got %d", provider.gotPage)
+	}
+}
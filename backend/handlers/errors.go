@@ -0,0 +1,55 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+
+	"go-movie-explorer/middleware"
+	"go-movie-explorer/services"
+)
+
+// upstreamError はサービス層のエラーを種類に応じたステータスのAPIErrorに変換
+// 404はそのまま返し、TMDB側の問題は502/503/504としてAPIキーの問題と障害を区別できるようにする
+// エラーコードは "TMDB_" + 種類名（例: TMDB_RATE_LIMITED）
+func upstreamError(err error, message string) *middleware.APIError {
+	msg := fmt.Sprintf("%s: %v", message, err)
+
+	// クライアントが切断した場合はサーバーエラーとして扱わない
+	if errors.Is(err, services.ErrRequestCanceled) {
+		return middleware.NewClientClosedRequestError(msg)
+	}
+
+	// 地域を決定できない場合はクライアントの指定不足
+	if errors.Is(err, services.ErrRegionRequired) {
+		return middleware.NewBadRequestError(msg + " (regionパラメータで地域を指定してください)").WithCode("REGION_REQUIRED")
+	}
+
+	if errors.Is(err, services.ErrGenreRequired) {
+		return middleware.NewBadRequestError(msg)
+	}
+	if errors.Is(err, services.ErrUnknownGenre) {
+		return middleware.NewBadRequestError(msg).WithCode("UNKNOWN_GENRE")
+	}
+
+	kind, ok := services.UpstreamErrorKindOf(err)
+	if !ok {
+		return middleware.NewInternalServerError(msg)
+	}
+
+	var apiErr *middleware.APIError
+	switch kind {
+	case services.UpstreamNotFound:
+		apiErr = middleware.NewNotFoundError(msg)
+	case services.UpstreamUnauthorized:
+		apiErr = middleware.NewBadGatewayError(msg + " (TMDB APIキーが無効です)")
+	case services.UpstreamRateLimited:
+		apiErr = middleware.NewServiceUnavailableError(msg + " (TMDBのレート制限中です)")
+	case services.UpstreamUnavailable:
+		apiErr = middleware.NewServiceUnavailableError(msg)
+	case services.UpstreamTimeout:
+		apiErr = middleware.NewGatewayTimeoutError(msg)
+	default:
+		apiErr = middleware.NewBadGatewayError(msg)
+	}
+	return apiErr.WithCode("TMDB_" + kind.String())
+}
@@ -0,0 +1,139 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"go-movie-explorer/middleware"
+	"go-movie-explorer/services"
+)
+
+// TestUpstreamError - サービス層のエラーがHTTPステータスに変換されることのテスト
+func TestUpstreamError(t *testing.T) {
+	tests := []struct {
+		name           string
+		err            error
+		expectedStatus int
+		expectedCode   string
+	}{
+		{name: "存在しない映画", err: &services.UpstreamError{Kind: services.UpstreamNotFound, StatusCode: 404}, expectedStatus: http.StatusNotFound, expectedCode: "TMDB_NOT_FOUND"},
+		{name: "APIキー不正", err: &services.UpstreamError{Kind: services.UpstreamUnauthorized, StatusCode: 401}, expectedStatus: http.StatusBadGateway, expectedCode: "TMDB_UNAUTHORIZED"},
+		{name: "レート制限", err: &services.UpstreamError{Kind: services.UpstreamRateLimited, StatusCode: 429}, expectedStatus: http.StatusServiceUnavailable, expectedCode: "TMDB_RATE_LIMITED"},
+		{name: "TMDB停止", err: &services.UpstreamError{Kind: services.UpstreamUnavailable, Err: services.ErrCircuitOpen}, expectedStatus: http.StatusServiceUnavailable, expectedCode: "TMDB_UNAVAILABLE"},
+		{name: "タイムアウト", err: &services.UpstreamError{Kind: services.UpstreamTimeout, Err: context.DeadlineExceeded}, expectedStatus: http.StatusGatewayTimeout, expectedCode: "TMDB_TIMEOUT"},
+		{name: "不正な応答", err: &services.UpstreamError{Kind: services.UpstreamBadGateway, StatusCode: 500}, expectedStatus: http.StatusBadGateway, expectedCode: "TMDB_BAD_GATEWAY"},
+		{name: "ジャンル未指定", err: services.ErrGenreRequired, expectedStatus: http.StatusBadRequest, expectedCode: ""},
+		{name: "クライアントの切断", err: fmt.Errorf("%w: %w", services.ErrRequestCanceled, context.Canceled), expectedStatus: middleware.StatusClientClosedRequest, expectedCode: "CLIENT_CLOSED_REQUEST"},
+		{name: "その他のエラー", err: errors.New("TMDB_API_KEYが設定されていません"), expectedStatus: http.StatusInternalServerError, expectedCode: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiErr := upstreamError(tt.err, "映画詳細取得失敗")
+			if apiErr.StatusCode != tt.expectedStatus {
+				t.Errorf("Expected status %d, got %d", tt.expectedStatus, apiErr.StatusCode)
+			}
+			if apiErr.Code != tt.expectedCode {
+				t.Errorf("Expected code %q, got %q", tt.expectedCode, apiErr.Code)
+			}
+		})
+	}
+}
+
+// TestErrorResponse_ProblemJSON - ミドルウェア経由のエラーがRFC 7807形式のJSONで返ることのテスト
+func TestErrorResponse_ProblemJSON(t *testing.T) {
+	provider := &fakeMovieProvider{err: &services.UpstreamError{Kind: services.UpstreamNotFound, StatusCode: 404}}
+	h := NewMovieHandler(provider)
+	handler := middleware.RequestIDMiddleware(middleware.LoggingHandler(h.MovieDetailHandler))
+
+	req := httptest.NewRequest("GET", "/api/movie/999999999", nil)
+	req.Header.Set(middleware.RequestIDHeader, "test-request-1")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("Expected status 404, got %d", rec.Code)
+	}
+	if ct := rec.Header().Get("Content-Type"); ct != middleware.ProblemContentType {
+		t.Errorf("Expected Content-Type %s, got %s", middleware.ProblemContentType, ct)
+	}
+
+	var problem middleware.ProblemDetails
+	if err := json.Unmarshal(rec.Body.Bytes(), &problem); err != nil {
+		t.Fatalf("Failed to decode error response: %v", err)
+	}
+	if problem.StatusCode != http.StatusNotFound || problem.Status != http.StatusNotFound {
+		t.Errorf("Expected statusCode/status 404, got %d/%d", problem.StatusCode, problem.Status)
+	}
+	if problem.Code != "TMDB_NOT_FOUND" {
+		t.Errorf("Expected code TMDB_NOT_FOUND, got %s", problem.Code)
+	}
+	if problem.Message == "" || problem.Message != problem.Detail {
+		t.Errorf("Expected message to match detail, got %q / %q", problem.Message, problem.Detail)
+	}
+	if problem.RequestID != "test-request-1" {
+		t.Errorf("Expected requestId test-request-1, got %s", problem.RequestID)
+	}
+}
+
+// TestErrorResponse_RoutingErrors - 未定義ルート・許可されていないメソッド・panicがJSONエラーになることのテスト
+func TestErrorResponse_RoutingErrors(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/genres", middleware.LoggingHandler(middleware.AllowMethods(
+		NewMovieHandler(&fakeMovieProvider{}).GenresHandler, http.MethodGet)))
+	mux.HandleFunc("/panic", func(w http.ResponseWriter, r *http.Request) { panic("boom") })
+	mux.HandleFunc("/", middleware.NotFoundHandler)
+	handler := middleware.RequestIDMiddleware(middleware.RecoveryMiddleware(mux))
+
+	tests := []struct {
+		name           string
+		method         string
+		path           string
+		expectedStatus int
+		expectedCode   string
+	}{
+		{name: "未定義のルート", method: "GET", path: "/api/unknown", expectedStatus: http.StatusNotFound, expectedCode: "ROUTE_NOT_FOUND"},
+		{name: "許可されていないメソッド", method: "POST", path: "/api/genres", expectedStatus: http.StatusMethodNotAllowed, expectedCode: "METHOD_NOT_ALLOWED"},
+		{name: "panic", method: "GET", path: "/panic", expectedStatus: http.StatusInternalServerError, expectedCode: "INTERNAL_SERVER_ERROR"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest(tt.method, tt.path, nil))
+
+			if rec.Code != tt.expectedStatus {
+				t.Fatalf("Expected status %d, got %d", tt.expectedStatus, rec.Code)
+			}
+			var problem middleware.ProblemDetails
+			if err := json.Unmarshal(rec.Body.Bytes(), &problem); err != nil {
+				t.Fatalf("Failed to decode error response: %v", err)
+			}
+			if problem.Code != tt.expectedCode {
+				t.Errorf("Expected code %s, got %s", tt.expectedCode, problem.Code)
+			}
+			if problem.RequestID == "" || problem.RequestID != rec.Header().Get(middleware.RequestIDHeader) {
+				t.Errorf("Expected requestId to match %s header, got %q", middleware.RequestIDHeader, problem.RequestID)
+			}
+		})
+	}
+}
+
+// TestMovieDetailHandler_NotFound - 存在しない映画IDで404を返すことのテスト
+func TestMovieDetailHandler_NotFound(t *testing.T) {
+	provider := &fakeMovieProvider{err: &services.UpstreamError{Kind: services.UpstreamNotFound, StatusCode: 404}}
+	h := NewMovieHandler(provider)
+	req := httptest.NewRequest("GET", "/api/movie/999999999", nil)
+	rec := httptest.NewRecorder()
+
+	err := h.MovieDetailHandler(rec, req)
+	apiErr, ok := err.(*middleware.APIError)
+	if !ok || apiErr.StatusCode != http.StatusNotFound {
+		t.Errorf("Expected 404 APIError, got %v", err)
+	}
+}
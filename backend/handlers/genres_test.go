@@ -0,0 +1,77 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"go-movie-explorer/middleware"
+	"go-movie-explorer/services"
+)
+
+// TestListMoviesByGenreHandler_MultiGenre - 複数ジャンル・除外ジャンルの指定のテスト
+func TestListMoviesByGenreHandler_MultiGenre(t *testing.T) {
+	tests := []struct {
+		query   string
+		ids     []int
+		any     bool
+		exclude []int
+	}{
+		{query: "genre_id=28", ids: []int{28}},
+		{query: "genre_id=28,12", ids: []int{28, 12}},
+		{query: "genre_id=28|35&exclude_genre=27,53", ids: []int{28, 35}, any: true, exclude: []int{27, 53}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			provider := &fakeMovieProvider{}
+			h := NewMovieHandler(provider)
+
+			if err := h.ListMoviesByGenreHandler(httptest.NewRecorder(), httptest.NewRequest("GET", "/api/movies/genre?"+tt.query, nil)); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !slices.Equal(provider.gotGenres.IDs, tt.ids) || provider.gotGenres.Any != tt.any || !slices.Equal(provider.gotExclude, tt.exclude) {
+				t.Errorf("Unexpected genre filter: %+v exclude=%v", provider.gotGenres, provider.gotExclude)
+			}
+		})
+	}
+}
+
+// TestListMoviesByGenreHandler_InvalidGenres - 不正なジャンル指定を400で拒否することのテスト
+func TestListMoviesByGenreHandler_InvalidGenres(t *testing.T) {
+	invalid := []string{
+		"",
+		"genre_id=",
+		"genre_id=action",
+		"genre_id=28,12|35",
+		"genre_id=28&exclude_genre=28",
+		"genre_id=28&exclude_genre=horror",
+	}
+	for _, query := range invalid {
+		t.Run(query, func(t *testing.T) {
+			provider := &fakeMovieProvider{}
+			h := NewMovieHandler(provider)
+
+			err := h.ListMoviesByGenreHandler(httptest.NewRecorder(), httptest.NewRequest("GET", "/api/movies/genre?"+query, nil))
+			apiErr, ok := err.(*middleware.APIError)
+			if !ok || apiErr.StatusCode != http.StatusBadRequest {
+				t.Errorf("Expected 400 APIError, got %v", err)
+			}
+			if provider.callCount != 0 {
+				t.Errorf("Expected provider not to be called, got %d calls", provider.callCount)
+			}
+		})
+	}
+
+	t.Run("存在しないジャンルIDは400", func(t *testing.T) {
+		h := NewMovieHandler(&fakeMovieProvider{err: fmt.Errorf("%w: %d", services.ErrUnknownGenre, 99999)})
+
+		err := h.ListMoviesByGenreHandler(httptest.NewRecorder(), httptest.NewRequest("GET", "/api/movies/genre?genre_id=99999", nil))
+		apiErr, ok := err.(*middleware.APIError)
+		if !ok || apiErr.StatusCode != http.StatusBadRequest || apiErr.Code != "UNKNOWN_GENRE" {
+			t.Errorf("Expected 400 UNKNOWN_GENRE, got %v", err)
+		}
+	})
+}
@@ -1,45 +1,61 @@
 package handlers
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"time"
 
-	"go-movie-explorer/services"
+	"go-movie-explorer/middleware"
 )
 
 var startTime = time.Now()
 
-// HealthHandler はTMDB APIバージョンを含むヘルスチェックハンドラー
-func HealthHandler(w http.ResponseWriter, r *http.Request) {
-	// TMDBのPingを実行
-	pinger := &services.TmdbPinger{}
-	err := pinger.Ping(r.Context())
-	
-	// レスポンス作成
-	var tmdbStatus string
-	if err != nil {
-		tmdbStatus = "CONNECTION_FAILED"
-	} else {
-		tmdbStatus = "SUCCESS"
-	}
-	
-	response := map[string]interface{}{
-		"uptime":  int(time.Since(startTime).Seconds()),
-		"version": "TMDB-" + services.GetTMDBAPIVersion(),
-		"status": map[string]string{
-			"TMDB_API_CONNECTION": tmdbStatus,
-		},
-	}
-	
-	w.Header().Set("Content-Type", "application/json")
-	
-	// ヘルスチェックが失敗した場合は500を返す
-	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-	}
-	
-	if err := json.NewEncoder(w).Encode(response); err != nil {
-		http.Error(w, "Failed to encode health status", http.StatusInternalServerError)
+// HealthChecker はヘルスチェック対象の外部サービス（services.TMDBClientが実装）
+type HealthChecker interface {
+	Name() string
+	Ping(ctx context.Context) error
+	APIVersion() string
+	BreakerState() string // サーキットブレーカーの状態（CLOSED / OPEN / HALF_OPEN / DISABLED）
+}
+
+// NewHealthHandler はTMDB APIバージョンを含むヘルスチェックハンドラーを作成
+func NewHealthHandler(checker HealthChecker) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// TMDBのPingを実行
+		pingErr := checker.Ping(r.Context())
+
+		// レスポンス作成
+		var tmdbStatus string
+		if pingErr != nil {
+			tmdbStatus = "CONNECTION_FAILED"
+		} else {
+			tmdbStatus = "SUCCESS"
+		}
+
+		response := map[string]interface{}{
+			"uptime":  int(time.Since(startTime).Seconds()),
+			"version": checker.Name() + "-" + checker.APIVersion(),
+			"status": map[string]string{
+				"TMDB_API_CONNECTION":  tmdbStatus,
+				"TMDB_CIRCUIT_BREAKER": checker.BreakerState(),
+			},
+		}
+
+		// ステータスを書き込む前にエンコードし、失敗した場合はproblem+jsonで返す
+		body, err := json.Marshal(response)
+		if err != nil {
+			middleware.WriteError(w, r, middleware.NewInternalServerError(fmt.Sprintf("ヘルスチェック結果のエンコードに失敗しました: %v", err)))
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		// ヘルスチェックが失敗した場合は500を返す
+		if pingErr != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		w.Write(append(body, '\n'))
 	}
-}
\ No newline at end of file
+}
@@ -0,0 +1,49 @@
+package handlers
+
+import (
+	"net/http"
+
+	"go-movie-explorer/middleware"
+)
+
+// 映画のキーワード取得ハンドラー /api/movie/{id}/keywords
+func (h *MovieHandler) MovieKeywordsHandler(w http.ResponseWriter, r *http.Request) error {
+	movieID, err := pathID(r, "id", "映画")
+	if err != nil {
+		return err
+	}
+
+	keywords, err := h.provider.GetMovieKeywords(r.Context(), movieID)
+	if err != nil {
+		return upstreamError(err, "キーワード取得失敗")
+	}
+	return writeJSON(w, keywords)
+}
+
+// キーワード検索ハンドラー /api/keywords/search?query=
+func (h *MovieHandler) SearchKeywordsHandler(w http.ResponseWriter, r *http.Request) error {
+	query := r.URL.Query().Get("query")
+	if query == "" {
+		return middleware.NewBadRequestError("検索クエリが指定されていません")
+	}
+
+	keywords, err := h.provider.SearchKeywords(r.Context(), query, pageParam(r))
+	if err != nil {
+		return upstreamError(err, "TMDB キーワード検索API呼び出し失敗")
+	}
+	return writeJSON(w, keywords)
+}
+
+// キーワード別映画取得ハンドラー /api/keywords/{id}/movies
+func (h *MovieHandler) KeywordMoviesHandler(w http.ResponseWriter, r *http.Request) error {
+	keywordID, err := pathID(r, "id", "キーワード")
+	if err != nil {
+		return err
+	}
+
+	movies, err := h.provider.GetKeywordMovies(r.Context(), keywordID, pageParam(r))
+	if err != nil {
+		return upstreamError(err, "キーワード別映画取得失敗")
+	}
+	return writeJSON(w, movies)
+}
@@ -0,0 +1,85 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"go-movie-explorer/middleware"
+	"go-movie-explorer/models"
+)
+
+// TestKeywordHandlers - キーワード関連ハンドラーのテスト
+func TestKeywordHandlers(t *testing.T) {
+	t.Run("映画のキーワードを返す", func(t *testing.T) {
+		provider := &fakeMovieProvider{}
+		h := NewMovieHandler(provider)
+		req := httptest.NewRequest("GET", "/api/movie/105/keywords", nil)
+		req.SetPathValue("id", "105")
+		rec := httptest.NewRecorder()
+
+		if err := h.MovieKeywordsHandler(rec, req); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		var keywords models.MovieKeywords
+		if err := json.NewDecoder(rec.Body).Decode(&keywords); err != nil {
+			t.Fatalf("Failed to decode response: %v", err)
+		}
+		if provider.gotID != 105 || keywords.MovieID != 105 || len(keywords.Keywords) != 1 {
+			t.Errorf("Unexpected keywords: %+v", keywords)
+		}
+	})
+
+	t.Run("キーワードを検索する", func(t *testing.T) {
+		provider := &fakeMovieProvider{}
+		h := NewMovieHandler(provider)
+
+		if err := h.SearchKeywordsHandler(httptest.NewRecorder(), httptest.NewRequest("GET", "/api/keywords/search?query=heist&page=2", nil)); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if provider.gotQuery != "heist" || provider.gotPage != 2 {
+			t.Errorf("Unexpected search: query=%q page=%d", provider.gotQuery, provider.gotPage)
+		}
+	})
+
+	t.Run("キーワード別の映画一覧を返す", func(t *testing.T) {
+		provider := &fakeMovieProvider{}
+		h := NewMovieHandler(provider)
+		req := httptest.NewRequest("GET", "/api/keywords/4379/movies?page=3", nil)
+		req.SetPathValue("id", "4379")
+		rec := httptest.NewRecorder()
+
+		if err := h.KeywordMoviesHandler(rec, req); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		var movies models.MoviesResponse
+		if err := json.NewDecoder(rec.Body).Decode(&movies); err != nil {
+			t.Fatalf("Failed to decode response: %v", err)
+		}
+		if provider.gotID != 4379 || provider.gotPage != 3 || movies.Page != 3 || len(movies.Results) != 1 {
+			t.Errorf("Unexpected movies: %+v", movies)
+		}
+	})
+
+	t.Run("不正なリクエストは400", func(t *testing.T) {
+		provider := &fakeMovieProvider{}
+		h := NewMovieHandler(provider)
+
+		invalidID := httptest.NewRequest("GET", "/api/keywords/abc/movies", nil)
+		invalidID.SetPathValue("id", "abc")
+		errs := []error{
+			h.KeywordMoviesHandler(httptest.NewRecorder(), invalidID),
+			h.SearchKeywordsHandler(httptest.NewRecorder(), httptest.NewRequest("GET", "/api/keywords/search", nil)),
+		}
+		for _, err := range errs {
+			apiErr, ok := err.(*middleware.APIError)
+			if !ok || apiErr.StatusCode != http.StatusBadRequest {
+				t.Errorf("Expected 400 APIError, got %v", err)
+			}
+		}
+		if provider.callCount != 0 {
+			t.Errorf("Expected provider not to be called, got %d calls", provider.callCount)
+		}
+	})
+}
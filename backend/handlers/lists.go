@@ -0,0 +1,54 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"go-movie-explorer/middleware"
+	"go-movie-explorer/models"
+	"go-movie-explorer/services"
+)
+
+// 上映中作品取得ハンドラー /api/movies/now_playing
+func (h *MovieHandler) NowPlayingMoviesHandler(w http.ResponseWriter, r *http.Request) error {
+	return h.movieList(w, r, h.provider.GetNowPlayingMovies, "上映中作品取得失敗")
+}
+
+// 公開予定作品取得ハンドラー /api/movies/upcoming
+func (h *MovieHandler) UpcomingMoviesHandler(w http.ResponseWriter, r *http.Request) error {
+	return h.movieList(w, r, h.provider.GetUpcomingMovies, "公開予定作品取得失敗")
+}
+
+// 高評価作品取得ハンドラー /api/movies/top_rated
+func (h *MovieHandler) TopRatedMoviesHandler(w http.ResponseWriter, r *http.Request) error {
+	return h.movieList(w, r, h.provider.GetTopRatedMovies, "高評価作品取得失敗")
+}
+
+// トレンド作品取得ハンドラー /api/movies/trending?window=day|week
+func (h *MovieHandler) TrendingMoviesHandler(w http.ResponseWriter, r *http.Request) error {
+	windowValue := r.URL.Query().Get("window")
+	window, ok := services.ParseTrendingWindow(windowValue)
+	if !ok {
+		return middleware.NewBadRequestError(fmt.Sprintf("無効なwindowです: %s（指定可能: day, week）", windowValue))
+	}
+
+	movies, err := h.provider.GetTrendingMovies(r.Context(), window, pageParam(r))
+	if err != nil {
+		return upstreamError(err, "トレンド作品取得失敗")
+	}
+	return writeJSON(w, movies)
+}
+
+// movieList は映画リストハンドラーの共通処理（地域はLocaleMiddlewareでコンテキストに設定済み）
+func (h *MovieHandler) movieList(
+	w http.ResponseWriter, r *http.Request,
+	fetch func(ctx context.Context, page int) (*models.DatedMoviesResponse, error),
+	message string,
+) error {
+	movies, err := fetch(r.Context(), pageParam(r))
+	if err != nil {
+		return upstreamError(err, message)
+	}
+	return writeJSON(w, movies)
+}
@@ -0,0 +1,96 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"go-movie-explorer/middleware"
+	"go-movie-explorer/services"
+)
+
+// TestMovieListHandlers - 上映中・公開予定・高評価作品ハンドラーのテスト
+func TestMovieListHandlers(t *testing.T) {
+	tests := []struct {
+		name      string
+		handler   func(h *MovieHandler) func(http.ResponseWriter, *http.Request) error
+		withDates bool
+	}{
+		{name: "now_playing", handler: func(h *MovieHandler) func(http.ResponseWriter, *http.Request) error { return h.NowPlayingMoviesHandler }, withDates: true},
+		{name: "upcoming", handler: func(h *MovieHandler) func(http.ResponseWriter, *http.Request) error { return h.UpcomingMoviesHandler }, withDates: true},
+		{name: "top_rated", handler: func(h *MovieHandler) func(http.ResponseWriter, *http.Request) error { return h.TopRatedMoviesHandler }, withDates: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			provider := &fakeMovieProvider{}
+			h := NewMovieHandler(provider)
+			rec := httptest.NewRecorder()
+
+			if err := tt.handler(h)(rec, httptest.NewRequest("GET", "/api/movies/"+tt.name+"?page=2", nil)); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			var raw map[string]interface{}
+			if err := json.NewDecoder(rec.Body).Decode(&raw); err != nil {
+				t.Fatalf("Failed to decode response: %v", err)
+			}
+			if provider.gotPage != 2 || raw["page"] != float64(2) {
+				t.Errorf("Expected page 2, got provider=%d response=%v", provider.gotPage, raw["page"])
+			}
+			if _, ok := raw["dates"]; ok != tt.withDates {
+				t.Errorf("Expected dates present=%v, got %v", tt.withDates, raw["dates"])
+			}
+		})
+	}
+
+	t.Run("TMDBのエラーは上流エラーとして返す", func(t *testing.T) {
+		h := NewMovieHandler(&fakeMovieProvider{err: &services.UpstreamError{Kind: services.UpstreamUnavailable, Err: errors.New("503")}})
+
+		err := h.NowPlayingMoviesHandler(httptest.NewRecorder(), httptest.NewRequest("GET", "/api/movies/now_playing", nil))
+		apiErr, ok := err.(*middleware.APIError)
+		if !ok || apiErr.StatusCode != http.StatusServiceUnavailable {
+			t.Errorf("Expected 503 APIError, got %v", err)
+		}
+	})
+}
+
+// TestTrendingMoviesHandler - トレンド作品ハンドラーのwindowの検証のテスト
+func TestTrendingMoviesHandler(t *testing.T) {
+	tests := []struct {
+		query    string
+		expected services.TrendingWindow
+		valid    bool
+	}{
+		{query: "", expected: services.TrendingDay, valid: true},
+		{query: "window=day", expected: services.TrendingDay, valid: true},
+		{query: "window=week", expected: services.TrendingWeek, valid: true},
+		{query: "window=month", valid: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			provider := &fakeMovieProvider{}
+			h := NewMovieHandler(provider)
+
+			err := h.TrendingMoviesHandler(httptest.NewRecorder(), httptest.NewRequest("GET", "/api/movies/trending?"+tt.query, nil))
+			if !tt.valid {
+				apiErr, ok := err.(*middleware.APIError)
+				if !ok || apiErr.StatusCode != http.StatusBadRequest {
+					t.Errorf("Expected 400 APIError, got %v", err)
+				}
+				if provider.callCount != 0 {
+					t.Errorf("Expected provider not to be called, got %d calls", provider.callCount)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if provider.gotWindow != tt.expected {
+				t.Errorf("Expected window %q, got %q", tt.expected, provider.gotWindow)
+			}
+		})
+	}
+}
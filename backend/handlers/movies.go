@@ -3,18 +3,31 @@ package handlers
 import (
 	"encoding/json"
 	"fmt"
+	"log"
 	"net/http"
 	"strconv"
 	"strings"
+	"sync"
 
 	"go-movie-explorer/middleware"
+	"go-movie-explorer/models"
 	"go-movie-explorer/services"
 )
 
+// MovieHandler は映画関連APIのハンドラー群
+// データ取得はMovieProvider経由で行うため、テストではフェイクに差し替えられる
+type MovieHandler struct {
+	provider services.MovieProvider
+}
+
+// NewMovieHandler はMovieProviderを使用するハンドラーを作成
+func NewMovieHandler(provider services.MovieProvider) *MovieHandler {
+	return &MovieHandler{provider: provider}
+}
+
 // 映画一覧取得APIハンドラー /api/movies
-func MoviesHandler(w http.ResponseWriter, r *http.Request) error {
+func (h *MovieHandler) MoviesHandler(w http.ResponseWriter, r *http.Request) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusOK)
 
 	// クエリパラメータ取得
 	pageStr := r.URL.Query().Get("page")
@@ -25,12 +38,19 @@ func MoviesHandler(w http.ResponseWriter, r *http.Request) error {
 		}
 	}
 
+	// 絞り込み条件（genre_id=28,12 / watch_provider=8|119&monetization=flatrate 等）
+	opts, err := parseDiscoverOptions(r)
+	if err != nil {
+		return err
+	}
+
 	// サービス層でTMDB APIから映画一覧を取得（API仕様変更や他サービス連携時はここを編集）
-	moviesResp, err := services.GetMoviesFromTMDB(page)
+	moviesResp, err := h.provider.GetMovies(r.Context(), page, opts)
 	if err != nil {
-		return middleware.NewInternalServerError(fmt.Sprintf("TMDB API呼び出し失敗: %v", err))
+		return upstreamError(err, "TMDB API呼び出し失敗")
 	}
 
+	w.WriteHeader(http.StatusOK)
 	// レスポンスをJSONで返却（レスポンス形式を変更したい場合はここを編集）
 	if err := json.NewEncoder(w).Encode(moviesResp); err != nil {
 		return middleware.NewInternalServerError(fmt.Sprintf("JSONレスポンスのエンコードに失敗しました: %v", err))
@@ -39,7 +59,7 @@ func MoviesHandler(w http.ResponseWriter, r *http.Request) error {
 }
 
 // 映画詳細取得ハンドラー /api/movie/{id}
-func MovieDetailHandler(w http.ResponseWriter, r *http.Request) error {
+func (h *MovieHandler) MovieDetailHandler(w http.ResponseWriter, r *http.Request) error {
 	w.Header().Set("Content-Type", "application/json")
 	prefix := "/api/movie/"
 	if !strings.HasPrefix(r.URL.Path, prefix) {
@@ -53,11 +73,74 @@ func MovieDetailHandler(w http.ResponseWriter, r *http.Request) error {
 		return middleware.NewBadRequestError("無効な映画IDです")
 	}
 	
-	// サービス層でTMDB APIから映画詳細を取得
-	movieDetail, err := services.GetMovieDetailFromTMDB(movieID)
-
+	// include=credits,videos,collection のように追加で含める情報
+	include, err := parseInclude(r, "credits", "videos", "collection")
 	if err != nil {
-		return middleware.NewInternalServerError(fmt.Sprintf("映画詳細取得失敗: %v", err))
+		return err
+	}
+
+	// 詳細・出演者・動画はいずれも映画IDのみで取得できるため並行してTMDBに問い合わせる
+	// シリーズ中の位置はコレクションIDが必要なため、詳細の取得後に出演者・動画と並行して取得する
+	var (
+		movieDetail                      *models.MovieDetail
+		credits                          *models.Credits
+		videos                           *models.MovieVideos
+		detailErr, creditsErr, videosErr error
+		collectionErr                    error
+		wg                               sync.WaitGroup
+	)
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		movieDetail, detailErr = h.provider.GetMovieDetail(r.Context(), movieID)
+		if detailErr != nil || !include["collection"] || movieDetail.BelongsToCollection == nil {
+			return
+		}
+		var collection *models.Collection
+		if collection, collectionErr = h.provider.GetCollection(r.Context(), movieDetail.BelongsToCollection.ID); collectionErr == nil {
+			services.SetCollectionPosition(movieDetail.BelongsToCollection, collection, movieID)
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		videos, videosErr = h.provider.GetMovieVideos(r.Context(), movieID)
+	}()
+	if include["credits"] {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			credits, creditsErr = h.provider.GetMovieCredits(r.Context(), movieID)
+		}()
+	}
+	wg.Wait()
+
+	if detailErr != nil {
+		return upstreamError(detailErr, "映画詳細取得失敗")
+	}
+	if creditsErr != nil {
+		return upstreamError(creditsErr, "出演者・スタッフ取得失敗")
+	}
+	movieDetail.Credits = credits
+
+	// シリーズ中の位置も付加情報のため、取得に失敗した場合は位置なしで詳細を返す
+	if collectionErr != nil {
+		if r.Context().Err() != nil {
+			return upstreamError(collectionErr, "コレクション取得失敗")
+		}
+		log.Printf("コレクションの取得に失敗したためシリーズ中の位置なしで映画詳細を返します (id=%d): %v", movieID, collectionErr)
+	}
+
+	// 予告編は詳細ページの付加情報のため、取得に失敗しても詳細は返す（include=videosの場合はエラー）
+	switch err := videosErr; {
+	case err == nil:
+		movieDetail.Trailer = videos.BestTrailer
+		if include["videos"] {
+			movieDetail.Videos = videos.Results
+		}
+	case include["videos"] || r.Context().Err() != nil:
+		return upstreamError(err, "動画取得失敗")
+	default:
+		log.Printf("予告編の取得に失敗したため予告編なしで映画詳細を返します (id=%d): %v", movieID, err)
 	}
 
 	w.WriteHeader(http.StatusOK)
@@ -69,7 +152,7 @@ func MovieDetailHandler(w http.ResponseWriter, r *http.Request) error {
 }
 
 // 映画検索APIハンドラー /api/movies/search
-func SearchMoviesHandler(w http.ResponseWriter, r *http.Request) error {
+func (h *MovieHandler) SearchMoviesHandler(w http.ResponseWriter, r *http.Request) error {
 	w.Header().Set("Content-Type", "application/json")
 
 	// クエリパラメータ取得
@@ -87,10 +170,16 @@ func SearchMoviesHandler(w http.ResponseWriter, r *http.Request) error {
 		}
 	}
 
+	// 公開年・成人向け作品の絞り込み（地域はLocaleMiddlewareでコンテキストに設定済み）
+	opts, err := parseSearchOptions(r)
+	if err != nil {
+		return err
+	}
+
 	// サービス層でTMDB APIから映画検索結果を取得
-	moviesResp, err := services.SearchMoviesFromTMDB(query, page)
+	moviesResp, err := h.provider.SearchMovies(r.Context(), query, page, opts)
 	if err != nil {
-		return middleware.NewInternalServerError(fmt.Sprintf("TMDB 検索API呼び出し失敗: %v", err))
+		return upstreamError(err, "TMDB 検索API呼び出し失敗")
 	}
 
 	w.WriteHeader(http.StatusOK)
@@ -102,7 +191,7 @@ func SearchMoviesHandler(w http.ResponseWriter, r *http.Request) error {
 }
 
 // 人気映画ランキング /api/movies/popular
-func PopularMoviesHandler(w http.ResponseWriter, r *http.Request) error {
+func (h *MovieHandler) PopularMoviesHandler(w http.ResponseWriter, r *http.Request) error {
 	w.Header().Set("Content-Type", "application/json")
 
 	// クエリパラメータ取得
@@ -113,9 +202,9 @@ func PopularMoviesHandler(w http.ResponseWriter, r *http.Request) error {
 	}
 
 	// サービス呼び出し
-	resp, err := services.GetPopularMoviesFromTMDB(page)
+	resp, err := h.provider.GetPopularMovies(r.Context(), page)
 	if err != nil {
-		return middleware.NewInternalServerError(fmt.Sprintf("TMDB API 呼び出し失敗: %v", err))
+		return upstreamError(err, "TMDB API 呼び出し失敗")
 	}
 
 	// レスポンス返却
@@ -126,15 +215,18 @@ func PopularMoviesHandler(w http.ResponseWriter, r *http.Request) error {
 	return nil
 }
 
-func ListMoviesByGenreHandler(w http.ResponseWriter, r *http.Request) error {
-	genreIDStr := r.URL.Query().Get("genre_id")
+// ジャンル別映画一覧ハンドラー /api/movies/genre?genre_id=28,12（全て含む） / genre_id=28|35（いずれかを含む）&exclude_genre=27
+func (h *MovieHandler) ListMoviesByGenreHandler(w http.ResponseWriter, r *http.Request) error {
 	pageStr := r.URL.Query().Get("page")
 
-	// ジャンルIDを数値に変換
-	genreID, err := strconv.Atoi(genreIDStr)
-	if err != nil || genreID < 1 {
+	// ジャンルIDを数値のリストに変換
+	if r.URL.Query().Get("genre_id") == "" {
 		return middleware.NewBadRequestError("無効なジャンルIDです")
 	}
+	genres, exclude, err := genreQuery(r.URL.Query())
+	if err != nil {
+		return err
+	}
 
 	// ページ番号取得
 	page := 1
@@ -144,9 +236,9 @@ func ListMoviesByGenreHandler(w http.ResponseWriter, r *http.Request) error {
 		}
 	}
 
-	result, err := services.GetMoviesByGenreFromTMDB(genreID, page)
+	result, err := h.provider.GetMoviesByGenre(r.Context(), genres, exclude, page)
 	if err != nil {
-		return middleware.NewInternalServerError(fmt.Sprintf("ジャンルの取得に失敗しました。: %v", err))
+		return upstreamError(err, "ジャンルの取得に失敗しました。")
 	}
 
 	w.Header().Set("Content-Type", "application/json")
@@ -156,13 +248,13 @@ func ListMoviesByGenreHandler(w http.ResponseWriter, r *http.Request) error {
 }
 
 // ジャンル一覧取得APIハンドラー  /api/genres
-func GenresHandler(w http.ResponseWriter, r *http.Request) error {
+func (h *MovieHandler) GenresHandler(w http.ResponseWriter, r *http.Request) error {
 	w.Header().Set("Content-Type", "application/json")
 
 	// サービス層でTMDB APIからジャンル一覧を取得
-	genresResp, err := services.GetGenresFromTMDB()
+	genresResp, err := h.provider.GetGenres(r.Context())
 	if err != nil {
-		return middleware.NewInternalServerError(fmt.Sprintf("TMDB ジャンル一覧の取得に呼び出し失敗しました: %v", err))
+		return upstreamError(err, "TMDB ジャンル一覧の取得に呼び出し失敗しました")
 	}
 
 	w.WriteHeader(http.StatusOK)
@@ -1,17 +1,27 @@
 package handlers
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"os"
 	"strconv"
+	"sync"
 	"testing"
 
+	"go-movie-explorer/middleware"
 	"go-movie-explorer/models"
+	"go-movie-explorer/services"
 )
 
+// newTMDBMovieHandler は環境変数の設定で実際のTMDBに接続するハンドラーを作成（統合テスト用）
+func newTMDBMovieHandler() *MovieHandler {
+	return NewMovieHandler(services.NewTMDBClient(nil))
+}
+
 // TestMoviesHandler - 映画一覧取得ハンドラーのテスト
 func TestMoviesHandler(t *testing.T) {
 	// 実際のTMDB APIキーがない場合はスキップ
@@ -114,7 +124,7 @@ func TestMoviesHandler(t *testing.T) {
 			recorder := httptest.NewRecorder()
 
 			// ハンドラー関数をAppHandler型として呼び出し
-			appHandler := MoviesHandler
+			appHandler := newTMDBMovieHandler().MoviesHandler
 			err = appHandler(recorder, req)
 
 			// エラーがある場合はログに記録（実際のサーバーでは500エラーになる）
@@ -188,7 +198,7 @@ func TestMovieDetailHandler(t *testing.T) {
 			recorder := httptest.NewRecorder()
 
 			// ハンドラー関数呼び出し
-			appHandler := MovieDetailHandler
+			appHandler := newTMDBMovieHandler().MovieDetailHandler
 			err = appHandler(recorder, req)
 
 			// エラーの確認
@@ -277,7 +287,7 @@ func TestSearchMoviesHandler(t *testing.T) {
 			recorder := httptest.NewRecorder()
 
 			// ハンドラー関数呼び出し
-			appHandler := SearchMoviesHandler
+			appHandler := newTMDBMovieHandler().SearchMoviesHandler
 			err = appHandler(recorder, req)
 
 			// エラーの確認
@@ -367,7 +377,7 @@ func TestListMoviesByGenreHandler(t *testing.T) {
 			recorder := httptest.NewRecorder()
 
 			// ハンドラー関数呼び出し
-			appHandler := ListMoviesByGenreHandler
+			appHandler := newTMDBMovieHandler().ListMoviesByGenreHandler
 			err = appHandler(recorder, req)
 
 			// エラーの確認
@@ -463,9 +473,10 @@ func TestGenresHandlerWithServeMux(t *testing.T) {
 	}
 
 	// ServeMuxを使用してハンドラーを登録
+	handler := newTMDBMovieHandler()
 	mux := http.NewServeMux()
 	mux.HandleFunc("/api/genres", func(w http.ResponseWriter, r *http.Request) {
-		_ = GenresHandler(w, r)
+		_ = handler.GenresHandler(w, r)
 	})
 
 	tests := []struct {
@@ -507,3 +518,393 @@ func TestGenresHandlerWithServeMux(t *testing.T) {
 		})
 	}
 }
+
+// fakeMovieProvider はテスト用のMovieProvider実装（TMDB APIキー不要）
+type fakeMovieProvider struct {
+	err         error
+	gotPage     int
+	gotID       int
+	gotQuery    string
+	gotGenres   services.IDFilter
+	gotExclude  []int
+	gotSort     services.PersonMoviesSort
+	gotFilter   services.MovieListFilter
+	gotDiscover services.DiscoverOptions
+	gotWindow   services.TrendingWindow
+	gotType     services.SearchMediaType
+	gotSearch   services.SearchOptions
+	callCount   int
+
+	videosErr     error // 動画取得のみ失敗させる場合に設定
+	collectionErr error // コレクション取得のみ失敗させる場合に設定
+	collectionID  int   // 設定した場合、映画詳細をこのコレクションのシリーズ作品にする
+
+	mu         sync.Mutex      // 映画詳細ハンドラーから並行して呼ばれるメソッド用
+	concurrent *sync.WaitGroup // 設定した場合、詳細関連の呼び出しは全て揃うまで待つ（並行実行の確認用）
+}
+
+// detailCall は映画詳細ハンドラーから並行して呼ばれるメソッドの呼び出しを記録する
+func (f *fakeMovieProvider) detailCall(id int) {
+	if f.concurrent != nil {
+		f.concurrent.Done()
+		f.concurrent.Wait()
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.callCount++
+	f.gotID = id
+}
+
+func (f *fakeMovieProvider) GetMovies(ctx context.Context, page int, opts services.DiscoverOptions) (*models.MoviesResponse, error) {
+	f.callCount++
+	f.gotPage = page
+	f.gotDiscover = opts
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &models.MoviesResponse{Page: page, TotalPages: 10, Results: []models.Movie{{ID: 1, Title: "Fake"}}}, nil
+}
+
+func (f *fakeMovieProvider) GetMovieDetail(ctx context.Context, id int) (*models.MovieDetail, error) {
+	f.detailCall(id)
+	if f.err != nil {
+		return nil, f.err
+	}
+	detail := &models.MovieDetail{ID: id, Title: "Fake Detail"}
+	if f.collectionID != 0 {
+		detail.BelongsToCollection = &models.MovieCollection{BelongsToCollection: models.BelongsToCollection{ID: f.collectionID, Name: "Fake Collection"}}
+	}
+	return detail, nil
+}
+
+func (f *fakeMovieProvider) SearchMovies(ctx context.Context, query string, page int, opts services.SearchOptions) (*models.MoviesResponse, error) {
+	f.callCount++
+	f.gotQuery = query
+	f.gotSearch = opts
+	f.gotPage = page
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &models.MoviesResponse{Page: page}, nil
+}
+
+func (f *fakeMovieProvider) GetPopularMovies(ctx context.Context, page int) (*models.MoviesResponse, error) {
+	f.callCount++
+	f.gotPage = page
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &models.MoviesResponse{Page: page}, nil
+}
+
+func (f *fakeMovieProvider) GetMoviesByGenre(ctx context.Context, genres services.IDFilter, exclude []int, page int) (*models.GenreMovieListResponse, error) {
+	f.callCount++
+	f.gotGenres = genres
+	f.gotExclude = exclude
+	f.gotPage = page
+	if f.err != nil {
+		return nil, f.err
+	}
+	if len(genres.IDs) == 0 {
+		return nil, services.ErrGenreRequired
+	}
+	return &models.GenreMovieListResponse{GenreID: genres.IDs[0], GenreIDs: genres.IDs, Page: page}, nil
+}
+
+func (f *fakeMovieProvider) GetGenres(ctx context.Context) (*models.GenreListResponse, error) {
+	f.callCount++
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &models.GenreListResponse{Genres: []models.Genre{{ID: 28, Name: "Action"}}}, nil
+}
+
+func (f *fakeMovieProvider) GetMovieCredits(ctx context.Context, id int) (*models.Credits, error) {
+	f.detailCall(id)
+	if f.err != nil {
+		return nil, f.err
+	}
+	director := models.CrewMember{ID: 10, Name: "Fake Director", Job: "Director", Department: "Directing"}
+	return &models.Credits{
+		MovieID:   id,
+		Cast:      []models.CastMember{{ID: 1, Name: "Fake Actor", Character: "Hero"}},
+		Crew:      map[string][]models.CrewMember{"Directing": {director}},
+		Directors: []models.CrewMember{director},
+		Writers:   []models.CrewMember{},
+	}, nil
+}
+
+func (f *fakeMovieProvider) GetMovieVideos(ctx context.Context, id int) (*models.MovieVideos, error) {
+	f.detailCall(id)
+	if f.videosErr != nil {
+		return nil, f.videosErr
+	}
+	if f.err != nil {
+		return nil, f.err
+	}
+	trailer := models.Video{Key: "abc", Site: "YouTube", Type: "Trailer", Language: "ja"}
+	return &models.MovieVideos{MovieID: id, BestTrailer: &trailer, Results: []models.Video{trailer}}, nil
+}
+
+func (f *fakeMovieProvider) GetMovieRecommendations(ctx context.Context, id, page int, filter services.MovieListFilter) (*models.MoviesResponse, error) {
+	f.callCount++
+	f.gotID = id
+	f.gotPage = page
+	f.gotFilter = filter
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &models.MoviesResponse{Page: page, Results: []models.Movie{{ID: 2, Title: "Recommended"}}}, nil
+}
+
+func (f *fakeMovieProvider) GetSimilarMovies(ctx context.Context, id, page int, filter services.MovieListFilter) (*models.MoviesResponse, error) {
+	f.callCount++
+	f.gotID = id
+	f.gotPage = page
+	f.gotFilter = filter
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &models.MoviesResponse{Page: page, Results: []models.Movie{{ID: 3, Title: "Similar"}}}, nil
+}
+
+func (f *fakeMovieProvider) GetMovieWatchProviders(ctx context.Context, id int) (*models.MovieWatchProviders, error) {
+	f.callCount++
+	f.gotID = id
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &models.MovieWatchProviders{
+		MovieID:  id,
+		Region:   "JP",
+		Flatrate: []models.WatchProvider{{ProviderID: 8, ProviderName: "Netflix"}},
+		Rent:     []models.WatchProvider{},
+		Buy:      []models.WatchProvider{},
+	}, nil
+}
+
+func (f *fakeMovieProvider) GetMovieReleases(ctx context.Context, id int) (*models.MovieReleases, error) {
+	f.callCount++
+	f.gotID = id
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &models.MovieReleases{
+		MovieID:       id,
+		Region:        "JP",
+		Certification: "PG12",
+		Countries: []models.CountryReleases{{
+			Country:       "JP",
+			Certification: "PG12",
+			Releases:      []models.Release{{Type: "theatrical", ReleaseDate: "1999-12-11", Certification: "PG12"}},
+		}},
+	}, nil
+}
+
+func (f *fakeMovieProvider) GetCollection(ctx context.Context, id int) (*models.Collection, error) {
+	f.mu.Lock()
+	f.callCount++
+	f.gotID = id
+	f.mu.Unlock()
+	if f.collectionErr != nil {
+		return nil, f.collectionErr
+	}
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &models.Collection{
+		ID:   id,
+		Name: "Fake Collection",
+		Parts: []models.Movie{
+			{ID: 1, Title: "Part 1", ReleaseDate: "2001-12-19"},
+			{ID: 2, Title: "Part 2", ReleaseDate: "2002-12-18"},
+		},
+	}, nil
+}
+
+func (f *fakeMovieProvider) GetNowPlayingMovies(ctx context.Context, page int) (*models.DatedMoviesResponse, error) {
+	return f.datedMovies(page, &models.DateRange{Minimum: "2025-06-01", Maximum: "2025-07-13"})
+}
+
+func (f *fakeMovieProvider) GetUpcomingMovies(ctx context.Context, page int) (*models.DatedMoviesResponse, error) {
+	return f.datedMovies(page, &models.DateRange{Minimum: "2025-07-14", Maximum: "2025-08-04"})
+}
+
+func (f *fakeMovieProvider) GetTopRatedMovies(ctx context.Context, page int) (*models.DatedMoviesResponse, error) {
+	return f.datedMovies(page, nil)
+}
+
+func (f *fakeMovieProvider) GetTrendingMovies(ctx context.Context, window services.TrendingWindow, page int) (*models.MoviesResponse, error) {
+	f.callCount++
+	f.gotPage = page
+	f.gotWindow = window
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &models.MoviesResponse{Page: page, TotalPages: 1, TotalResults: 1, Results: []models.Movie{{ID: 1, Title: "Trending Movie"}}}, nil
+}
+
+func (f *fakeMovieProvider) datedMovies(page int, dates *models.DateRange) (*models.DatedMoviesResponse, error) {
+	f.callCount++
+	f.gotPage = page
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &models.DatedMoviesResponse{
+		Page:         page,
+		TotalPages:   1,
+		TotalResults: 1,
+		Results:      []models.Movie{{ID: 1, Title: "Fake Movie"}},
+		Dates:        dates,
+	}, nil
+}
+
+func (f *fakeMovieProvider) GetPerson(ctx context.Context, id int) (*models.Person, error) {
+	f.callCount++
+	f.gotID = id
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &models.Person{ID: id, Name: "Fake Person", KnownForDepartment: "Acting"}, nil
+}
+
+func (f *fakeMovieProvider) GetPersonMovies(ctx context.Context, id int, order services.PersonMoviesSort) (*models.PersonMoviesResponse, error) {
+	f.callCount++
+	f.gotID = id
+	f.gotSort = order
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &models.PersonMoviesResponse{PersonID: id, Sort: string(order), Results: []models.PersonMovie{}}, nil
+}
+
+func (f *fakeMovieProvider) SearchPeople(ctx context.Context, query string, page int) (*models.PeopleSearchResponse, error) {
+	f.callCount++
+	f.gotQuery = query
+	f.gotPage = page
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &models.PeopleSearchResponse{Page: page, Results: []models.PersonSummary{{ID: 1, Name: "Fake Person"}}}, nil
+}
+
+// TestMovieHandler_FakeProvider - フェイクプロバイダーを使ったハンドラーの単体テスト
+func TestMovieHandler_FakeProvider(t *testing.T) {
+	t.Run("映画一覧はページ番号をプロバイダーに渡す", func(t *testing.T) {
+		provider := &fakeMovieProvider{}
+		h := NewMovieHandler(provider)
+		req := httptest.NewRequest("GET", "/api/movies?page=3", nil)
+		rec := httptest.NewRecorder()
+
+		if err := h.MoviesHandler(rec, req); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if provider.gotPage != 3 {
+			t.Errorf("Expected page 3, got %d", provider.gotPage)
+		}
+
+		var response models.MoviesResponse
+		if err := json.NewDecoder(rec.Body).Decode(&response); err != nil {
+			t.Fatalf("Failed to decode JSON response: %v", err)
+		}
+		if response.Page != 3 || len(response.Results) != 1 {
+			t.Errorf("Unexpected response: %+v", response)
+		}
+	})
+
+	t.Run("映画詳細はパスのIDをプロバイダーに渡す", func(t *testing.T) {
+		provider := &fakeMovieProvider{}
+		h := NewMovieHandler(provider)
+		req := httptest.NewRequest("GET", "/api/movie/550", nil)
+		rec := httptest.NewRecorder()
+
+		if err := h.MovieDetailHandler(rec, req); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if provider.gotID != 550 {
+			t.Errorf("Expected movie ID 550, got %d", provider.gotID)
+		}
+		if rec.Code != http.StatusOK {
+			t.Errorf("Expected status 200, got %d", rec.Code)
+		}
+	})
+
+	t.Run("無効な映画IDではプロバイダーを呼ばない", func(t *testing.T) {
+		provider := &fakeMovieProvider{}
+		h := NewMovieHandler(provider)
+		req := httptest.NewRequest("GET", "/api/movie/invalid", nil)
+		rec := httptest.NewRecorder()
+
+		if err := h.MovieDetailHandler(rec, req); err == nil {
+			t.Error("Expected error but got none")
+		}
+		if provider.callCount != 0 {
+			t.Errorf("Expected provider not to be called, got %d calls", provider.callCount)
+		}
+	})
+
+	t.Run("プロバイダーのエラーはAPIErrorとして返す", func(t *testing.T) {
+		provider := &fakeMovieProvider{err: errors.New("upstream down")}
+		h := NewMovieHandler(provider)
+		req := httptest.NewRequest("GET", "/api/genres", nil)
+		rec := httptest.NewRecorder()
+
+		err := h.GenresHandler(rec, req)
+		apiErr, ok := err.(*middleware.APIError)
+		if !ok {
+			t.Fatalf("Expected *middleware.APIError, got %T", err)
+		}
+		if apiErr.StatusCode != http.StatusInternalServerError {
+			t.Errorf("Expected status 500, got %d", apiErr.StatusCode)
+		}
+	})
+}
+
+func (f *fakeMovieProvider) GetMovieKeywords(ctx context.Context, id int) (*models.MovieKeywords, error) {
+	f.callCount++
+	f.gotID = id
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &models.MovieKeywords{MovieID: id, Keywords: []models.Keyword{{ID: 4379, Name: "time travel"}}}, nil
+}
+
+func (f *fakeMovieProvider) SearchKeywords(ctx context.Context, query string, page int) (*models.KeywordSearchResponse, error) {
+	f.callCount++
+	f.gotQuery = query
+	f.gotPage = page
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &models.KeywordSearchResponse{Page: page, Results: []models.Keyword{{ID: 10051, Name: "heist"}}}, nil
+}
+
+func (f *fakeMovieProvider) GetKeywordMovies(ctx context.Context, id, page int) (*models.MoviesResponse, error) {
+	f.callCount++
+	f.gotID = id
+	f.gotPage = page
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &models.MoviesResponse{Page: page, TotalPages: 1, TotalResults: 1, Results: []models.Movie{{ID: 1, Title: "Keyword Movie"}}}, nil
+}
+
+func (f *fakeMovieProvider) Search(ctx context.Context, query string, mediaType services.SearchMediaType, page int) (*models.SearchResponse, error) {
+	f.callCount++
+	f.gotQuery = query
+	f.gotType = mediaType
+	f.gotPage = page
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &models.SearchResponse{
+		Page:         page,
+		TotalPages:   1,
+		TotalResults: 2,
+		Counts:       models.SearchCounts{Movie: 1, Person: 1},
+		Results: []models.SearchResult{
+			{MediaType: "movie", ID: 1, Title: "Fake Movie"},
+			{MediaType: "person", ID: 2, Name: "Fake Person"},
+		},
+	}, nil
+}
@@ -0,0 +1,78 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"go-movie-explorer/middleware"
+)
+
+// pathID はパスパラメータ（/api/movie/{id}/... の{id}等）を正の整数として取得
+// labelはエラーメッセージ用のリソース名（例: 映画）
+func pathID(r *http.Request, name, label string) (int, error) {
+	id, err := strconv.Atoi(r.PathValue(name))
+	if err != nil || id < 1 {
+		return 0, middleware.NewBadRequestError(fmt.Sprintf("無効な%sIDです", label))
+	}
+	return id, nil
+}
+
+// pageParam はpageクエリパラメータを取得（未指定・不正な値の場合は1）
+func pageParam(r *http.Request) int {
+	if p, err := strconv.Atoi(r.URL.Query().Get("page")); err == nil && p > 0 {
+		return p
+	}
+	return 1
+}
+
+// boolParam は真偽値のクエリパラメータを取得（未指定の場合はdefaultValue）
+func boolParam(r *http.Request, name string, defaultValue bool) (bool, error) {
+	value := r.URL.Query().Get(name)
+	if value == "" {
+		return defaultValue, nil
+	}
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, middleware.NewBadRequestError(fmt.Sprintf("%sはtrueまたはfalseで指定してください: %s", name, value))
+	}
+	return b, nil
+}
+
+// parseInclude はカンマ区切りのincludeパラメータを検証し、指定された値の集合を返す
+func parseInclude(r *http.Request, allowed ...string) (map[string]bool, error) {
+	include := map[string]bool{}
+	value := r.URL.Query().Get("include")
+	if value == "" {
+		return include, nil
+	}
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		valid := false
+		for _, a := range allowed {
+			if item == a {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			return nil, middleware.NewBadRequestError(fmt.Sprintf("無効なincludeです: %s（指定可能: %s）", item, strings.Join(allowed, ", ")))
+		}
+		include[item] = true
+	}
+	return include, nil
+}
+
+// writeJSON はレスポンスをJSONで返却
+func writeJSON(w http.ResponseWriter, v interface{}) error {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		return middleware.NewInternalServerError(fmt.Sprintf("JSONレスポンスのエンコードに失敗しました: %v", err))
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+
+	"go-movie-explorer/middleware"
+	"go-movie-explorer/services"
+)
+
+// 人物詳細取得ハンドラー /api/person/{id}
+func (h *MovieHandler) PersonDetailHandler(w http.ResponseWriter, r *http.Request) error {
+	personID, err := pathID(r, "id", "人物")
+	if err != nil {
+		return err
+	}
+
+	person, err := h.provider.GetPerson(r.Context(), personID)
+	if err != nil {
+		return upstreamError(err, "人物詳細取得失敗")
+	}
+	return writeJSON(w, person)
+}
+
+// 人物のフィルモグラフィー取得ハンドラー /api/person/{id}/movies?sort=release_date.desc
+func (h *MovieHandler) PersonMoviesHandler(w http.ResponseWriter, r *http.Request) error {
+	personID, err := pathID(r, "id", "人物")
+	if err != nil {
+		return err
+	}
+
+	sortValue := r.URL.Query().Get("sort")
+	order, ok := services.ParsePersonMoviesSort(sortValue)
+	if !ok {
+		return middleware.NewBadRequestError(fmt.Sprintf(
+			"無効なsortです: %s（指定可能: release_date.desc, release_date.asc, popularity.desc, popularity.asc）", sortValue))
+	}
+
+	movies, err := h.provider.GetPersonMovies(r.Context(), personID, order)
+	if err != nil {
+		return upstreamError(err, "フィルモグラフィー取得失敗")
+	}
+	return writeJSON(w, movies)
+}
+
+// 人物検索ハンドラー /api/people/search?query=
+func (h *MovieHandler) SearchPeopleHandler(w http.ResponseWriter, r *http.Request) error {
+	query := r.URL.Query().Get("query")
+	if query == "" {
+		return middleware.NewBadRequestError("検索クエリが指定されていません")
+	}
+
+	people, err := h.provider.SearchPeople(r.Context(), query, pageParam(r))
+	if err != nil {
+		return upstreamError(err, "TMDB 人物検索API呼び出し失敗")
+	}
+	return writeJSON(w, people)
+}
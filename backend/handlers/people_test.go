@@ -0,0 +1,100 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"go-movie-explorer/middleware"
+	"go-movie-explorer/models"
+	"go-movie-explorer/services"
+)
+
+// TestPersonHandlers - 人物関連ハンドラーのテスト
+func TestPersonHandlers(t *testing.T) {
+	t.Run("人物詳細は人物IDをプロバイダーに渡す", func(t *testing.T) {
+		provider := &fakeMovieProvider{}
+		h := NewMovieHandler(provider)
+		req := httptest.NewRequest("GET", "/api/person/287", nil)
+		req.SetPathValue("id", "287")
+		rec := httptest.NewRecorder()
+
+		if err := h.PersonDetailHandler(rec, req); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		var person models.Person
+		if err := json.NewDecoder(rec.Body).Decode(&person); err != nil {
+			t.Fatalf("Failed to decode response: %v", err)
+		}
+		if provider.gotID != 287 || person.ID != 287 {
+			t.Errorf("Expected person 287, got provider=%d response=%d", provider.gotID, person.ID)
+		}
+	})
+
+	t.Run("フィルモグラフィーは並び順をプロバイダーに渡す", func(t *testing.T) {
+		tests := []struct {
+			query    string
+			expected services.PersonMoviesSort
+		}{
+			{query: "", expected: services.SortReleaseDateDesc},
+			{query: "?sort=popularity.desc", expected: services.SortPopularityDesc},
+			{query: "?sort=release_date.asc", expected: services.SortReleaseDateAsc},
+		}
+		for _, tt := range tests {
+			provider := &fakeMovieProvider{}
+			h := NewMovieHandler(provider)
+			req := httptest.NewRequest("GET", "/api/person/287/movies"+tt.query, nil)
+			req.SetPathValue("id", "287")
+
+			if err := h.PersonMoviesHandler(httptest.NewRecorder(), req); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if provider.gotSort != tt.expected {
+				t.Errorf("%q: expected sort %s, got %s", tt.query, tt.expected, provider.gotSort)
+			}
+		}
+	})
+
+	t.Run("無効なパラメータは400を返す", func(t *testing.T) {
+		tests := []struct {
+			name    string
+			handler func(h *MovieHandler) middleware.AppHandler
+			url     string
+			id      string
+		}{
+			{name: "無効な人物ID", handler: func(h *MovieHandler) middleware.AppHandler { return h.PersonDetailHandler }, url: "/api/person/abc", id: "abc"},
+			{name: "無効なsort", handler: func(h *MovieHandler) middleware.AppHandler { return h.PersonMoviesHandler }, url: "/api/person/287/movies?sort=title", id: "287"},
+			{name: "検索クエリなし", handler: func(h *MovieHandler) middleware.AppHandler { return h.SearchPeopleHandler }, url: "/api/people/search"},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				provider := &fakeMovieProvider{}
+				req := httptest.NewRequest("GET", tt.url, nil)
+				req.SetPathValue("id", tt.id)
+
+				err := tt.handler(NewMovieHandler(provider))(httptest.NewRecorder(), req)
+				apiErr, ok := err.(*middleware.APIError)
+				if !ok || apiErr.StatusCode != http.StatusBadRequest {
+					t.Errorf("Expected 400 APIError, got %v", err)
+				}
+				if provider.callCount != 0 {
+					t.Errorf("Expected provider not to be called, got %d calls", provider.callCount)
+				}
+			})
+		}
+	})
+
+	t.Run("人物検索はクエリとページ番号をプロバイダーに渡す", func(t *testing.T) {
+		provider := &fakeMovieProvider{}
+		h := NewMovieHandler(provider)
+		rec := httptest.NewRecorder()
+
+		if err := h.SearchPeopleHandler(rec, httptest.NewRequest("GET", "/api/people/search?query=keanu&page=2", nil)); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if provider.gotQuery != "keanu" || provider.gotPage != 2 {
+			t.Errorf("Expected query keanu page 2, got %q page %d", provider.gotQuery, provider.gotPage)
+		}
+	})
+}
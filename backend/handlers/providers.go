@@ -0,0 +1,19 @@
+package handlers
+
+import (
+	"net/http"
+)
+
+// 配信サービス取得ハンドラー /api/movie/{id}/providers?region=JP
+func (h *MovieHandler) MovieWatchProvidersHandler(w http.ResponseWriter, r *http.Request) error {
+	movieID, err := pathID(r, "id", "映画")
+	if err != nil {
+		return err
+	}
+
+	providers, err := h.provider.GetMovieWatchProviders(r.Context(), movieID)
+	if err != nil {
+		return upstreamError(err, "配信サービス取得失敗")
+	}
+	return writeJSON(w, providers)
+}
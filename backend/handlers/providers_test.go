@@ -0,0 +1,99 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"go-movie-explorer/middleware"
+	"go-movie-explorer/models"
+	"go-movie-explorer/services"
+)
+
+// TestMovieWatchProvidersHandler - 配信サービス取得ハンドラーのテスト
+func TestMovieWatchProvidersHandler(t *testing.T) {
+	provider := &fakeMovieProvider{}
+	h := NewMovieHandler(provider)
+	req := httptest.NewRequest("GET", "/api/movie/550/providers?region=JP", nil)
+	req.SetPathValue("id", "550")
+	rec := httptest.NewRecorder()
+
+	if err := h.MovieWatchProvidersHandler(rec, req); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var providers models.MovieWatchProviders
+	if err := json.NewDecoder(rec.Body).Decode(&providers); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	if provider.gotID != 550 || len(providers.Flatrate) != 1 {
+		t.Errorf("Unexpected providers: %+v", providers)
+	}
+}
+
+// TestMovieWatchProvidersHandler_RegionRequired - 地域が無い場合に400を返すことのテスト
+func TestMovieWatchProvidersHandler_RegionRequired(t *testing.T) {
+	h := NewMovieHandler(&fakeMovieProvider{err: fmt.Errorf("wrap: %w", services.ErrRegionRequired)})
+	req := httptest.NewRequest("GET", "/api/movie/550/providers", nil)
+	req.SetPathValue("id", "550")
+
+	err := h.MovieWatchProvidersHandler(httptest.NewRecorder(), req)
+	apiErr, ok := err.(*middleware.APIError)
+	if !ok || apiErr.StatusCode != http.StatusBadRequest || apiErr.Code != "REGION_REQUIRED" {
+		t.Errorf("Expected 400 REGION_REQUIRED, got %v", err)
+	}
+}
+
+// TestMoviesHandler_WatchProviders - 映画一覧の配信サービス絞り込みパラメータのテスト
+func TestMoviesHandler_WatchProviders(t *testing.T) {
+	t.Run("watch_providerとmonetizationを渡す", func(t *testing.T) {
+		provider := &fakeMovieProvider{}
+		h := NewMovieHandler(provider)
+
+		req := httptest.NewRequest("GET", "/api/movies?watch_provider=8|119&monetization=flatrate,rent", nil)
+		if err := h.MoviesHandler(httptest.NewRecorder(), req); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		opts := provider.gotDiscover
+		if len(opts.WatchProviders.IDs) != 2 || opts.WatchProviders.IDs[1] != 119 || !opts.WatchProviders.Any || len(opts.MonetizationTypes) != 2 {
+			t.Errorf("Unexpected discover options: %+v", opts)
+		}
+	})
+
+	t.Run("カンマ区切りは全ての配信サービスで視聴できる作品", func(t *testing.T) {
+		provider := &fakeMovieProvider{}
+		h := NewMovieHandler(provider)
+
+		req := httptest.NewRequest("GET", "/api/movies?watch_provider=8,119", nil)
+		if err := h.MoviesHandler(httptest.NewRecorder(), req); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if got := provider.gotDiscover.WatchProviders; len(got.IDs) != 2 || got.Any {
+			t.Errorf("Unexpected watch providers: %+v", got)
+		}
+	})
+
+	invalid := []string{
+		"watch_provider=abc",
+		"watch_provider=0",
+		"watch_provider=8,119|337",
+		"watch_provider=8&monetization=subscription",
+		"monetization=flatrate",
+	}
+	for _, query := range invalid {
+		t.Run(query, func(t *testing.T) {
+			provider := &fakeMovieProvider{}
+			h := NewMovieHandler(provider)
+
+			err := h.MoviesHandler(httptest.NewRecorder(), httptest.NewRequest("GET", "/api/movies?"+query, nil))
+			apiErr, ok := err.(*middleware.APIError)
+			if !ok || apiErr.StatusCode != http.StatusBadRequest {
+				t.Errorf("Expected 400 APIError, got %v", err)
+			}
+			if provider.callCount != 0 {
+				t.Errorf("Expected provider not to be called, got %d calls", provider.callCount)
+			}
+		})
+	}
+}
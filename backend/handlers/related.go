@@ -0,0 +1,76 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"go-movie-explorer/middleware"
+	"go-movie-explorer/models"
+	"go-movie-explorer/services"
+)
+
+// maxExcludeIDs はexclude_idsに指定できる映画IDの最大数
+const maxExcludeIDs = 100
+
+// おすすめ作品取得ハンドラー /api/movie/{id}/recommendations
+func (h *MovieHandler) MovieRecommendationsHandler(w http.ResponseWriter, r *http.Request) error {
+	return h.relatedMovies(w, r, h.provider.GetMovieRecommendations, "おすすめ作品取得失敗")
+}
+
+// 類似作品取得ハンドラー /api/movie/{id}/similar
+func (h *MovieHandler) SimilarMoviesHandler(w http.ResponseWriter, r *http.Request) error {
+	return h.relatedMovies(w, r, h.provider.GetSimilarMovies, "類似作品取得失敗")
+}
+
+// relatedMovies は関連作品一覧ハンドラーの共通処理
+// exclude_ids（カンマ区切りの視聴済み映画ID）とinclude_adult（成人向け作品を含めるか）で絞り込む
+func (h *MovieHandler) relatedMovies(
+	w http.ResponseWriter, r *http.Request,
+	fetch func(ctx context.Context, id, page int, filter services.MovieListFilter) (*models.MoviesResponse, error),
+	message string,
+) error {
+	movieID, err := pathID(r, "id", "映画")
+	if err != nil {
+		return err
+	}
+	filter, err := parseMovieListFilter(r)
+	if err != nil {
+		return err
+	}
+
+	movies, err := fetch(r.Context(), movieID, pageParam(r), filter)
+	if err != nil {
+		return upstreamError(err, message)
+	}
+	return writeJSON(w, movies)
+}
+
+// parseMovieListFilter はexclude_ids・include_adultパラメータを絞り込み条件に変換
+func parseMovieListFilter(r *http.Request) (services.MovieListFilter, error) {
+	query := r.URL.Query()
+	filter := services.MovieListFilter{ExcludeIDs: map[int]bool{}}
+
+	if value := query.Get("exclude_ids"); value != "" {
+		ids := strings.Split(value, ",")
+		if len(ids) > maxExcludeIDs {
+			return filter, middleware.NewBadRequestError(fmt.Sprintf("exclude_idsは%d件までです", maxExcludeIDs))
+		}
+		for _, s := range ids {
+			id, err := strconv.Atoi(strings.TrimSpace(s))
+			if err != nil || id < 1 {
+				return filter, middleware.NewBadRequestError(fmt.Sprintf("exclude_idsに無効な映画IDが含まれています: %s", s))
+			}
+			filter.ExcludeIDs[id] = true
+		}
+	}
+
+	includeAdult, err := boolParam(r, "include_adult", false)
+	if err != nil {
+		return filter, err
+	}
+	filter.IncludeAdult = includeAdult
+	return filter, nil
+}
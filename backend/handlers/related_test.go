@@ -0,0 +1,76 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"go-movie-explorer/middleware"
+	"go-movie-explorer/models"
+)
+
+// TestRelatedMoviesHandlers - おすすめ・類似作品ハンドラーのテスト
+func TestRelatedMoviesHandlers(t *testing.T) {
+	handlers := map[string]func(h *MovieHandler) middleware.AppHandler{
+		"recommendations": func(h *MovieHandler) middleware.AppHandler { return h.MovieRecommendationsHandler },
+		"similar":         func(h *MovieHandler) middleware.AppHandler { return h.SimilarMoviesHandler },
+	}
+
+	for name, handler := range handlers {
+		t.Run(name+"は絞り込み条件とページ番号をプロバイダーに渡す", func(t *testing.T) {
+			provider := &fakeMovieProvider{}
+			req := httptest.NewRequest("GET", "/api/movie/550/"+name+"?page=2&exclude_ids=603,%20680&include_adult=true", nil)
+			req.SetPathValue("id", "550")
+			rec := httptest.NewRecorder()
+
+			if err := handler(NewMovieHandler(provider))(rec, req); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if provider.gotID != 550 || provider.gotPage != 2 {
+				t.Errorf("Expected id 550 page 2, got id %d page %d", provider.gotID, provider.gotPage)
+			}
+			if !provider.gotFilter.ExcludeIDs[603] || !provider.gotFilter.ExcludeIDs[680] || !provider.gotFilter.IncludeAdult {
+				t.Errorf("Unexpected filter: %+v", provider.gotFilter)
+			}
+
+			var response models.MoviesResponse
+			if err := json.NewDecoder(rec.Body).Decode(&response); err != nil {
+				t.Fatalf("Failed to decode response: %v", err)
+			}
+			if len(response.Results) != 1 {
+				t.Errorf("Unexpected response: %+v", response)
+			}
+		})
+	}
+
+	t.Run("成人向け作品はデフォルトで除外", func(t *testing.T) {
+		provider := &fakeMovieProvider{}
+		req := httptest.NewRequest("GET", "/api/movie/550/similar", nil)
+		req.SetPathValue("id", "550")
+
+		if err := NewMovieHandler(provider).SimilarMoviesHandler(httptest.NewRecorder(), req); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if provider.gotFilter.IncludeAdult || len(provider.gotFilter.ExcludeIDs) != 0 {
+			t.Errorf("Unexpected default filter: %+v", provider.gotFilter)
+		}
+	})
+
+	t.Run("無効なパラメータは400を返す", func(t *testing.T) {
+		for _, query := range []string{"exclude_ids=abc", "exclude_ids=1,,2", "include_adult=maybe"} {
+			provider := &fakeMovieProvider{}
+			req := httptest.NewRequest("GET", "/api/movie/550/similar?"+query, nil)
+			req.SetPathValue("id", "550")
+
+			err := NewMovieHandler(provider).SimilarMoviesHandler(httptest.NewRecorder(), req)
+			apiErr, ok := err.(*middleware.APIError)
+			if !ok || apiErr.StatusCode != http.StatusBadRequest {
+				t.Errorf("%s: expected 400 APIError, got %v", query, err)
+			}
+			if provider.callCount != 0 {
+				t.Errorf("%s: expected provider not to be called", query)
+			}
+		}
+	})
+}
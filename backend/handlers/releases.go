@@ -0,0 +1,19 @@
+package handlers
+
+import (
+	"net/http"
+)
+
+// 公開日・年齢制限取得ハンドラー /api/movie/{id}/releases?region=JP
+func (h *MovieHandler) MovieReleasesHandler(w http.ResponseWriter, r *http.Request) error {
+	movieID, err := pathID(r, "id", "映画")
+	if err != nil {
+		return err
+	}
+
+	releases, err := h.provider.GetMovieReleases(r.Context(), movieID)
+	if err != nil {
+		return upstreamError(err, "公開日取得失敗")
+	}
+	return writeJSON(w, releases)
+}
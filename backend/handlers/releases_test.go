@@ -0,0 +1,29 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+
+	"go-movie-explorer/models"
+)
+
+// TestMovieReleasesHandler - 公開日・年齢制限取得ハンドラーのテスト
+func TestMovieReleasesHandler(t *testing.T) {
+	provider := &fakeMovieProvider{}
+	h := NewMovieHandler(provider)
+	req := httptest.NewRequest("GET", "/api/movie/550/releases?region=JP", nil)
+	req.SetPathValue("id", "550")
+	rec := httptest.NewRecorder()
+
+	if err := h.MovieReleasesHandler(rec, req); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var releases models.MovieReleases
+	if err := json.NewDecoder(rec.Body).Decode(&releases); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	if provider.gotID != 550 || releases.Certification != "PG12" || len(releases.Countries) != 1 {
+		t.Errorf("Unexpected releases: %+v", releases)
+	}
+}
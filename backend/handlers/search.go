@@ -0,0 +1,55 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+
+	"go-movie-explorer/middleware"
+	"go-movie-explorer/services"
+)
+
+// parseSearchOptions は映画検索（/api/movies/search）の絞り込みパラメータを検証して変換
+//
+//	year=1999&primary_release_year=1999&include_adult=true
+func parseSearchOptions(r *http.Request) (services.SearchOptions, error) {
+	query := r.URL.Query()
+	var opts services.SearchOptions
+	var err error
+
+	if opts.Year, err = intQuery(query, "year", 1800, 2100); err != nil {
+		return opts, err
+	}
+	if opts.PrimaryReleaseYear, err = intQuery(query, "primary_release_year", 1800, 2100); err != nil {
+		return opts, err
+	}
+	// 未指定の場合はサーバーの設定（TMDB_SEARCH_INCLUDE_ADULT）に従う
+	if query.Get("include_adult") != "" {
+		includeAdult, err := boolParam(r, "include_adult", false)
+		if err != nil {
+			return opts, err
+		}
+		opts.IncludeAdult = &includeAdult
+	}
+	return opts, nil
+}
+
+// 横断検索ハンドラー /api/search?query=&media_type=movie|person|collection|keyword
+func (h *MovieHandler) SearchHandler(w http.ResponseWriter, r *http.Request) error {
+	query := r.URL.Query().Get("query")
+	if query == "" {
+		return middleware.NewBadRequestError("検索クエリが指定されていません")
+	}
+
+	mediaTypeValue := r.URL.Query().Get("media_type")
+	mediaType, ok := services.ParseSearchMediaType(mediaTypeValue)
+	if !ok {
+		return middleware.NewBadRequestError(fmt.Sprintf(
+			"無効なmedia_typeです: %s（指定可能: movie, person, collection, keyword）", mediaTypeValue))
+	}
+
+	results, err := h.provider.Search(r.Context(), query, mediaType, pageParam(r))
+	if err != nil {
+		return upstreamError(err, "TMDB 横断検索API呼び出し失敗")
+	}
+	return writeJSON(w, results)
+}
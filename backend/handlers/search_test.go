@@ -0,0 +1,96 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"go-movie-explorer/middleware"
+	"go-movie-explorer/models"
+	"go-movie-explorer/services"
+)
+
+// TestSearchHandler - 横断検索ハンドラーのテスト
+func TestSearchHandler(t *testing.T) {
+	t.Run("種類毎の件数と結果を返す", func(t *testing.T) {
+		provider := &fakeMovieProvider{}
+		h := NewMovieHandler(provider)
+		rec := httptest.NewRecorder()
+
+		if err := h.SearchHandler(rec, httptest.NewRequest("GET", "/api/search?query=matrix&media_type=person&page=2", nil)); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if provider.gotQuery != "matrix" || provider.gotType != services.SearchPerson || provider.gotPage != 2 {
+			t.Errorf("Unexpected search: query=%q type=%q page=%d", provider.gotQuery, provider.gotType, provider.gotPage)
+		}
+		var resp models.SearchResponse
+		if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+			t.Fatalf("Failed to decode response: %v", err)
+		}
+		if resp.Counts.Movie != 1 || len(resp.Results) != 2 || resp.Results[1].MediaType != "person" {
+			t.Errorf("Unexpected response: %+v", resp)
+		}
+	})
+
+	for _, query := range []string{"", "query=matrix&media_type=tv"} {
+		t.Run("不正なリクエストは400: "+query, func(t *testing.T) {
+			provider := &fakeMovieProvider{}
+			h := NewMovieHandler(provider)
+
+			err := h.SearchHandler(httptest.NewRecorder(), httptest.NewRequest("GET", "/api/search?"+query, nil))
+			apiErr, ok := err.(*middleware.APIError)
+			if !ok || apiErr.StatusCode != http.StatusBadRequest {
+				t.Errorf("Expected 400 APIError, got %v", err)
+			}
+			if provider.callCount != 0 {
+				t.Errorf("Expected provider not to be called, got %d calls", provider.callCount)
+			}
+		})
+	}
+}
+
+// TestSearchMoviesHandler_Options - 映画検索の絞り込みパラメータのテスト
+func TestSearchMoviesHandler_Options(t *testing.T) {
+	t.Run("公開年・成人向け作品の指定を渡す", func(t *testing.T) {
+		provider := &fakeMovieProvider{}
+		h := NewMovieHandler(provider)
+
+		req := httptest.NewRequest("GET", "/api/movies/search?query=matrix&year=1999&primary_release_year=2000&include_adult=true", nil)
+		if err := h.SearchMoviesHandler(httptest.NewRecorder(), req); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		opts := provider.gotSearch
+		if opts.Year != 1999 || opts.PrimaryReleaseYear != 2000 || opts.IncludeAdult == nil || !*opts.IncludeAdult {
+			t.Errorf("Unexpected options: %+v", opts)
+		}
+	})
+
+	t.Run("include_adult未指定はサーバーのデフォルト", func(t *testing.T) {
+		provider := &fakeMovieProvider{}
+		h := NewMovieHandler(provider)
+
+		if err := h.SearchMoviesHandler(httptest.NewRecorder(), httptest.NewRequest("GET", "/api/movies/search?query=matrix", nil)); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if provider.gotSearch.IncludeAdult != nil {
+			t.Errorf("Expected nil IncludeAdult, got %v", *provider.gotSearch.IncludeAdult)
+		}
+	})
+
+	for _, query := range []string{"year=99", "primary_release_year=abc", "include_adult=maybe"} {
+		t.Run("不正な値は400: "+query, func(t *testing.T) {
+			provider := &fakeMovieProvider{}
+			h := NewMovieHandler(provider)
+
+			err := h.SearchMoviesHandler(httptest.NewRecorder(), httptest.NewRequest("GET", "/api/movies/search?query=matrix&"+query, nil))
+			apiErr, ok := err.(*middleware.APIError)
+			if !ok || apiErr.StatusCode != http.StatusBadRequest {
+				t.Errorf("Expected 400 APIError, got %v", err)
+			}
+			if provider.callCount != 0 {
+				t.Errorf("Expected provider not to be called, got %d calls", provider.callCount)
+			}
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package handlers
+
+import (
+	"net/http"
+)
+
+// 動画（予告編・ティーザー・クリップ）取得ハンドラー /api/movie/{id}/videos
+func (h *MovieHandler) MovieVideosHandler(w http.ResponseWriter, r *http.Request) error {
+	movieID, err := pathID(r, "id", "映画")
+	if err != nil {
+		return err
+	}
+
+	videos, err := h.provider.GetMovieVideos(r.Context(), movieID)
+	if err != nil {
+		return upstreamError(err, "動画取得失敗")
+	}
+	return writeJSON(w, videos)
+}
@@ -0,0 +1,99 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"go-movie-explorer/middleware"
+	"go-movie-explorer/models"
+	"go-movie-explorer/services"
+)
+
+// TestMovieVideosHandler - 動画取得ハンドラーのテスト
+func TestMovieVideosHandler(t *testing.T) {
+	provider := &fakeMovieProvider{}
+	h := NewMovieHandler(provider)
+	req := httptest.NewRequest("GET", "/api/movie/550/videos", nil)
+	req.SetPathValue("id", "550")
+	rec := httptest.NewRecorder()
+
+	if err := h.MovieVideosHandler(rec, req); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var videos models.MovieVideos
+	if err := json.NewDecoder(rec.Body).Decode(&videos); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	if provider.gotID != 550 || videos.BestTrailer == nil || len(videos.Results) != 1 {
+		t.Errorf("Unexpected videos: %+v", videos)
+	}
+}
+
+// TestMovieDetailHandler_Trailer - 映画詳細に予告編を含めることのテスト
+func TestMovieDetailHandler_Trailer(t *testing.T) {
+	t.Run("予告編を詳細に含める", func(t *testing.T) {
+		h := NewMovieHandler(&fakeMovieProvider{})
+		rec := httptest.NewRecorder()
+
+		if err := h.MovieDetailHandler(rec, httptest.NewRequest("GET", "/api/movie/550", nil)); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		var detail models.MovieDetail
+		if err := json.NewDecoder(rec.Body).Decode(&detail); err != nil {
+			t.Fatalf("Failed to decode response: %v", err)
+		}
+		if detail.Trailer == nil || detail.Trailer.Key != "abc" {
+			t.Errorf("Expected trailer in detail, got %+v", detail.Trailer)
+		}
+		if detail.Videos != nil {
+			t.Errorf("Expected no videos without include=videos, got %+v", detail.Videos)
+		}
+	})
+
+	t.Run("include=videosの場合は動画一覧も含める", func(t *testing.T) {
+		h := NewMovieHandler(&fakeMovieProvider{})
+		rec := httptest.NewRecorder()
+
+		if err := h.MovieDetailHandler(rec, httptest.NewRequest("GET", "/api/movie/550?include=videos", nil)); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		var detail models.MovieDetail
+		if err := json.NewDecoder(rec.Body).Decode(&detail); err != nil {
+			t.Fatalf("Failed to decode response: %v", err)
+		}
+		if len(detail.Videos) != 1 {
+			t.Errorf("Expected videos in detail, got %+v", detail.Videos)
+		}
+	})
+
+	t.Run("動画の取得に失敗しても詳細は返す", func(t *testing.T) {
+		provider := &fakeMovieProvider{videosErr: &services.UpstreamError{Kind: services.UpstreamTimeout, Err: errors.New("timeout")}}
+		h := NewMovieHandler(provider)
+		rec := httptest.NewRecorder()
+
+		if err := h.MovieDetailHandler(rec, httptest.NewRequest("GET", "/api/movie/550", nil)); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		var raw map[string]interface{}
+		if err := json.NewDecoder(rec.Body).Decode(&raw); err != nil {
+			t.Fatalf("Failed to decode response: %v", err)
+		}
+		if trailer, ok := raw["trailer"]; !ok || trailer != nil {
+			t.Errorf("Expected trailer to be null, got %v", trailer)
+		}
+	})
+
+	t.Run("include=videosで動画の取得に失敗した場合はエラー", func(t *testing.T) {
+		provider := &fakeMovieProvider{videosErr: &services.UpstreamError{Kind: services.UpstreamTimeout, Err: errors.New("timeout")}}
+		h := NewMovieHandler(provider)
+
+		err := h.MovieDetailHandler(httptest.NewRecorder(), httptest.NewRequest("GET", "/api/movie/550?include=videos", nil))
+		apiErr, ok := err.(*middleware.APIError)
+		if !ok || apiErr.StatusCode != http.StatusGatewayTimeout {
+			t.Errorf("Expected 504 APIError, got %v", err)
+		}
+	})
+}
@@ -8,6 +8,7 @@ import (
 
 	"go-movie-explorer/handlers"   // ハンドラー
 	"go-movie-explorer/middleware" // ミドルウェア
+	"go-movie-explorer/services"   // TMDBクライアント
 
 	"github.com/joho/godotenv" // .envファイルの読み込み
 )
@@ -57,28 +58,99 @@ func main() {
 	mux := http.NewServeMux()
 
 	// セキュリティミドルウェアを全体に適用
-	securedHandler := middleware.SecurityMiddleware(securityConfig)(mux)
+	// TMDB障害時に古いキャッシュを返した場合はStaleDataMiddlewareがヘッダーで通知する
+	// リクエストIDとpanic回復は最も外側に置き、CORSエラーやpanic時もJSONエラーにIDを含める
+	// LocaleMiddlewareはlanguage / region / Accept-LanguageからTMDBに渡す言語・地域を決定する
+	securedHandler := middleware.RequestIDMiddleware(middleware.RecoveryMiddleware(
+		middleware.SecurityMiddleware(securityConfig)(middleware.StaleDataMiddleware(middleware.LocaleMiddleware(mux)))))
+
+	// 参照系APIはGET/HEADのみ許可し、それ以外は405のJSONエラーを返す
+	readOnly := func(h middleware.AppHandler) http.HandlerFunc {
+		return middleware.LoggingHandler(middleware.AllowMethods(h, http.MethodGet, http.MethodHead))
+	}
+
+	// TMDBクライアントの作成（ハンドラーはMovieProviderとして利用）
+	tmdbConfig := services.DefaultTMDBConfig()
+	tmdbConfig.APIKey = tmdbApiKey
+	tmdbClient := services.NewTMDBClient(tmdbConfig)
+	movieHandler := handlers.NewMovieHandler(tmdbClient)
 
 	// ヘルスチェックエンドポイント
-	mux.HandleFunc("/healthz", handlers.HealthHandler)
+	mux.HandleFunc("/healthz", handlers.NewHealthHandler(tmdbClient))
 
 	// - /api/movies/search：映画検索APIエンドポイント
-	mux.HandleFunc("/api/movies/search", middleware.LoggingHandler(handlers.SearchMoviesHandler))
+	mux.HandleFunc("/api/movies/search", readOnly(movieHandler.SearchMoviesHandler))
 
 	// 映画ジャンル別取得
-	mux.HandleFunc("/api/movies/genre", middleware.LoggingHandler(handlers.ListMoviesByGenreHandler))
+	mux.HandleFunc("/api/movies/genre", readOnly(movieHandler.ListMoviesByGenreHandler))
 
 	// - /api/movies/popular : 人気映画ランキング
-	mux.HandleFunc("/api/movies/popular", middleware.LoggingHandler(handlers.PopularMoviesHandler))
+	mux.HandleFunc("/api/movies/popular", readOnly(movieHandler.PopularMoviesHandler))
+
+	// - /api/movies/now_playing, /api/movies/upcoming : 上映中・公開予定（regionの劇場公開日で判定、対象期間をdatesに含める）
+	// - /api/movies/top_rated : 高評価作品
+	mux.HandleFunc("/api/movies/now_playing", readOnly(movieHandler.NowPlayingMoviesHandler))
+	mux.HandleFunc("/api/movies/upcoming", readOnly(movieHandler.UpcomingMoviesHandler))
+	mux.HandleFunc("/api/movies/top_rated", readOnly(movieHandler.TopRatedMoviesHandler))
+
+	// - /api/movies/trending?window=day|week : トレンド作品（人気ランキングより短い期間の注目度）
+	mux.HandleFunc("/api/movies/trending", readOnly(movieHandler.TrendingMoviesHandler))
+
+	// - /api/movie/{id}/credits : 出演者・スタッフ取得
+	mux.HandleFunc("/api/movie/{id}/credits", readOnly(movieHandler.MovieCreditsHandler))
+
+	// - /api/movie/{id}/videos : 予告編・ティーザー・クリップ取得
+	mux.HandleFunc("/api/movie/{id}/videos", readOnly(movieHandler.MovieVideosHandler))
+
+	// - /api/movie/{id}/recommendations, /api/movie/{id}/similar : おすすめ・類似作品
+	mux.HandleFunc("/api/movie/{id}/recommendations", readOnly(movieHandler.MovieRecommendationsHandler))
+	mux.HandleFunc("/api/movie/{id}/similar", readOnly(movieHandler.SimilarMoviesHandler))
+
+	// - /api/movie/{id}/providers?region=JP : 配信サービス（見放題・レンタル・購入）
+	mux.HandleFunc("/api/movie/{id}/providers", readOnly(movieHandler.MovieWatchProvidersHandler))
+
+	// - /api/movie/{id}/releases?region=JP : 国毎の公開日・年齢制限
+	mux.HandleFunc("/api/movie/{id}/releases", readOnly(movieHandler.MovieReleasesHandler))
+
+	// - /api/movie/{id}/keywords : 映画のキーワード（タグ）
+	mux.HandleFunc("/api/movie/{id}/keywords", readOnly(movieHandler.MovieKeywordsHandler))
+
+	// - /api/collection/{id} : シリーズ作品（公開日順）
+	mux.HandleFunc("/api/collection/{id}", readOnly(movieHandler.CollectionHandler))
 
 	// - /api/movie/{id} : 映画詳細取得APIエンドポイント
-	mux.HandleFunc("/api/movie/", middleware.LoggingHandler(handlers.MovieDetailHandler))
+	mux.HandleFunc("/api/movie/", readOnly(movieHandler.MovieDetailHandler))
+
+	// - /api/person/{id} : 人物詳細、/api/person/{id}/movies : フィルモグラフィー
+	mux.HandleFunc("/api/person/{id}", readOnly(movieHandler.PersonDetailHandler))
+	mux.HandleFunc("/api/person/{id}/movies", readOnly(movieHandler.PersonMoviesHandler))
+
+	// - /api/search?media_type= : 映画・人物・コレクション・キーワードの横断検索（種類毎の件数を含む、一部の種類の失敗時はfailed_types）
+	mux.HandleFunc("/api/search", readOnly(movieHandler.SearchHandler))
+
+	// - /api/people/search : 人物検索
+	mux.HandleFunc("/api/people/search", readOnly(movieHandler.SearchPeopleHandler))
+
+	// - /api/keywords/search : キーワード検索、/api/keywords/{id}/movies : キーワード別映画
+	mux.HandleFunc("/api/keywords/search", readOnly(movieHandler.SearchKeywordsHandler))
+	mux.HandleFunc("/api/keywords/{id}/movies", readOnly(movieHandler.KeywordMoviesHandler))
 
 	// 映画一覧取得
-	mux.HandleFunc("/api/movies", middleware.LoggingHandler(handlers.MoviesHandler))
+	mux.HandleFunc("/api/movies", readOnly(movieHandler.MoviesHandler))
 
 	// - /api/genres : ジャンル一覧取得
-	mux.HandleFunc("/api/genres", middleware.LoggingHandler(handlers.GenresHandler))
+	mux.HandleFunc("/api/genres", readOnly(movieHandler.GenresHandler))
+
+	// - /admin/cache : レスポンスキャッシュの統計取得・削除（ADMIN_TOKEN設定時のみ有効）
+	if adminToken := os.Getenv("ADMIN_TOKEN"); adminToken != "" {
+		cacheAdmin := handlers.NewCacheAdminHandler(tmdbClient.Cache(), adminToken)
+		mux.HandleFunc("/admin/cache", middleware.LoggingHandler(cacheAdmin.ServeCache))
+	} else {
+		log.Println("ADMIN_TOKENが設定されていないため、管理用エンドポイントは無効です")
+	}
+
+	// 未定義のルートは404のJSONエラーを返す
+	mux.HandleFunc("/", middleware.NotFoundHandler)
 
 	log.Printf("Server starting on http://localhost%s\n", port)
 	log.Printf("Server listening on port %s", port)
@@ -1,13 +1,17 @@
 package middleware
 
 import (
+	"encoding/json"
+	"log"
 	"net/http"
+	"strings"
 )
 
 // APIError はHTTPステータスコード付きのカスタムエラー型
 type APIError struct {
 	StatusCode int    `json:"statusCode"`
 	Message    string `json:"message"`
+	Code       string `json:"code,omitempty"` // 機械判定用のエラーコード（空の場合はステータスから決定）
 }
 
 // Error はerrorインターフェースを実装
@@ -15,6 +19,98 @@ func (e *APIError) Error() string {
 	return e.Message
 }
 
+// WithCode はエラーコードを設定したAPIErrorを返す（メソッドチェーン用）
+func (e *APIError) WithCode(code string) *APIError {
+	e.Code = code
+	return e
+}
+
+// ProblemContentType はエラーレスポンスのContent-Type（RFC 7807）
+const ProblemContentType = "application/problem+json"
+
+// ProblemDetails はRFC 7807形式のエラーレスポンス
+// フロントエンドのAPIError型（statusCode / message）とも互換性を持たせている
+type ProblemDetails struct {
+	Type       string `json:"type"`
+	Title      string `json:"title"`
+	Status     int    `json:"status"`
+	Detail     string `json:"detail"`
+	Instance   string `json:"instance,omitempty"`
+	StatusCode int    `json:"statusCode"`
+	Message    string `json:"message"`
+	Code       string `json:"code"`
+	RequestID  string `json:"requestId,omitempty"`
+}
+
+// WriteError はAPIErrorをapplication/problem+json形式で書き込む
+func WriteError(w http.ResponseWriter, r *http.Request, apiErr *APIError) {
+	code := apiErr.Code
+	if code == "" {
+		code = defaultErrorCode(apiErr.StatusCode)
+	}
+
+	problem := ProblemDetails{
+		Type:       "about:blank",
+		Title:      http.StatusText(apiErr.StatusCode),
+		Status:     apiErr.StatusCode,
+		Detail:     apiErr.Message,
+		Instance:   r.URL.Path,
+		StatusCode: apiErr.StatusCode,
+		Message:    apiErr.Message,
+		Code:       code,
+		RequestID:  RequestIDFromContext(r.Context()),
+	}
+
+	h := w.Header()
+	h.Set("Content-Type", ProblemContentType)
+	h.Set("X-Content-Type-Options", "nosniff")
+	// 成功レスポンス用に設定されていたヘッダーは破棄する
+	h.Del("Content-Length")
+	h.Del("Cache-Control")
+	w.WriteHeader(apiErr.StatusCode)
+	if err := json.NewEncoder(w).Encode(problem); err != nil {
+		log.Printf("エラーレスポンスの書き込みに失敗: %v", err)
+	}
+}
+
+// writeHandlerError はハンドラーが返したエラーをレスポンスに変換する
+// APIError以外のエラーは内部情報を漏らさないよう500の固定メッセージにする
+func writeHandlerError(w http.ResponseWriter, r *http.Request, err error) {
+	if apiErr, ok := err.(*APIError); ok {
+		WriteError(w, r, apiErr)
+		return
+	}
+	WriteError(w, r, NewInternalServerError("Internal Server Error"))
+}
+
+// defaultErrorCode はHTTPステータスからエラーコードを決定（例: 404 -> NOT_FOUND）
+func defaultErrorCode(statusCode int) string {
+	text := http.StatusText(statusCode)
+	if text == "" {
+		return "ERROR"
+	}
+	return strings.ToUpper(strings.NewReplacer(" ", "_", "-", "_", "'", "").Replace(text))
+}
+
+// NotFoundHandler は未定義のルートに対して404のJSONエラーを返すハンドラー
+func NotFoundHandler(w http.ResponseWriter, r *http.Request) {
+	WriteError(w, r, NewNotFoundError("エンドポイントが見つかりません: "+r.URL.Path).WithCode("ROUTE_NOT_FOUND"))
+}
+
+// AllowMethods は許可したメソッド以外のリクエストに405のJSONエラーを返すAppHandlerを作成
+func AllowMethods(h AppHandler, methods ...string) AppHandler {
+	allow := strings.Join(methods, ", ")
+	return func(w http.ResponseWriter, r *http.Request) error {
+		for _, m := range methods {
+			if r.Method == m {
+				return h(w, r)
+			}
+		}
+		w.Header().Set("Allow", allow)
+		return NewAPIError(http.StatusMethodNotAllowed, "許可されていないメソッドです: "+r.Method)
+	}
+}
+
 // NewAPIError は新しいAPIErrorを作成
 func NewAPIError(statusCode int, message string) *APIError {
 	return &APIError{
@@ -45,4 +141,40 @@ func NewInternalServerError(message string) *APIError {
 		StatusCode: http.StatusInternalServerError,
 		Message:    message,
 	}
-}
\ No newline at end of file
+}
+
+// NewBadGatewayError は502 Bad Gatewayエラーを作成（上流APIが不正な応答を返した場合）
+func NewBadGatewayError(message string) *APIError {
+	return &APIError{
+		StatusCode: http.StatusBadGateway,
+		Message:    message,
+	}
+}
+
+// NewServiceUnavailableError は503 Service Unavailableエラーを作成（上流APIが停止・制限中の場合）
+func NewServiceUnavailableError(message string) *APIError {
+	return &APIError{
+		StatusCode: http.StatusServiceUnavailable,
+		Message:    message,
+	}
+}
+
+// StatusClientClosedRequest はクライアントがレスポンスを待たずに切断したことを表すステータス（nginxの499）
+const StatusClientClosedRequest = 499
+
+// NewClientClosedRequestError は499エラーを作成（クライアントが切断し、レスポンスは届かない）
+func NewClientClosedRequestError(message string) *APIError {
+	return &APIError{
+		StatusCode: StatusClientClosedRequest,
+		Message:    message,
+		Code:       "CLIENT_CLOSED_REQUEST",
+	}
+}
+
+// NewGatewayTimeoutError は504 Gateway Timeoutエラーを作成（上流APIがタイムアウトした場合）
+func NewGatewayTimeoutError(message string) *APIError {
+	return &APIError{
+		StatusCode: http.StatusGatewayTimeout,
+		Message:    message,
+	}
+}
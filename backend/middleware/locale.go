@@ -0,0 +1,44 @@
+package middleware
+
+import (
+	"net/http"
+
+	"go-movie-explorer/services"
+)
+
+// LocaleMiddleware はlanguage / regionクエリパラメータ（未指定の場合はAccept-Language）から
+// リクエストの言語・地域を決定し、サービス層がTMDBへ渡せるようコンテキストに設定するミドルウェア
+// どちらも決まらない場合はサーバー設定（TMDB_LANGUAGE / TMDB_REGION）が使われる
+func LocaleMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		var loc services.Locale
+
+		if value := query.Get("language"); value != "" {
+			lang, ok := services.ParseLanguage(value)
+			if !ok {
+				WriteError(w, r, NewBadRequestError("無効なlanguageです（例: ja, ja-JP）: "+value).WithCode("INVALID_LANGUAGE"))
+				return
+			}
+			loc.Language = lang
+		} else {
+			loc.Language = services.ParseAcceptLanguage(r.Header.Get("Accept-Language"))
+		}
+
+		if value := query.Get("region"); value != "" {
+			region, ok := services.ParseRegion(value)
+			if !ok {
+				WriteError(w, r, NewBadRequestError("無効なregionです（例: JP）: "+value).WithCode("INVALID_REGION"))
+				return
+			}
+			loc.Region = region
+		} else {
+			// ja-JPのように言語に地域が含まれていればそれを使用
+			loc.Region = services.RegionOf(loc.Language)
+		}
+
+		// レスポンスはAccept-Languageによって変わるため共有キャッシュに区別させる
+		w.Header().Add("Vary", "Accept-Language")
+		next.ServeHTTP(w, r.WithContext(services.WithLocale(r.Context(), loc)))
+	})
+}
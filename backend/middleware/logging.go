@@ -20,16 +20,18 @@ func LoggingHandler(h AppHandler) http.HandlerFunc {
 
 		// ハンドラーの実行とエラーハンドリング
 		if err := h(w, r); err != nil {
-			// エラーログの出力
-			log.Printf("[%s] %s - Error: %v", r.Method, r.URL.Path, err)
-
-			// APIErrorの場合は適切なステータスコードでレスポンス
-			if apiErr, ok := err.(*APIError); ok {
-				http.Error(w, apiErr.Message, apiErr.StatusCode)
-			} else {
-				// その他のエラーは500 Internal Server Error
-				http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+			// クライアントの切断はエラーとして記録しない
+			if apiErr, ok := err.(*APIError); ok && apiErr.StatusCode == StatusClientClosedRequest {
+				log.Printf("[%s] %s - Canceled by client (took %v)", r.Method, r.URL.Path, time.Since(start))
+				writeHandlerError(w, r, err)
+				return
 			}
+
+			// エラーログの出力
+			log.Printf("[%s] %s - Error (request_id=%s): %v", r.Method, r.URL.Path, RequestIDFromContext(r.Context()), err)
+
+			// APIErrorの場合は適切なステータスコード、その他は500でJSONエラーを返す
+			writeHandlerError(w, r, err)
 		} else {
 			// 成功時のログ出力（実行時間も含む）
 			duration := time.Since(start)
@@ -60,10 +62,10 @@ func DetailedLoggingHandler(h AppHandler) http.HandlerFunc {
 		// ハンドラーの実行とエラーハンドリング
 		if err := h(w, r); err != nil {
 			duration := time.Since(start)
-			log.Printf("[%s] %s - Error after %v: %v",
-				r.Method, r.URL.Path, duration, err)
+			log.Printf("[%s] %s - Error after %v (request_id=%s): %v",
+				r.Method, r.URL.Path, duration, RequestIDFromContext(r.Context()), err)
 
-			http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+			writeHandlerError(w, r, err)
 		} else {
 			duration := time.Since(start)
 			log.Printf("[%s] %s - Success in %v",
@@ -105,7 +107,7 @@ func ConfigurableLoggingHandler(config *RequestLoggingConfig) func(AppHandler) h
 			return SimpleLoggingHandler(func(w http.ResponseWriter, r *http.Request) {
 				if err := h(w, r); err != nil {
 					log.Printf("handler error: %v", err)
-					http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+					writeHandlerError(w, r, err)
 				}
 			})
 		default: // "standard"
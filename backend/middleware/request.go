@@ -0,0 +1,82 @@
+package middleware
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"log"
+	"net/http"
+	"runtime/debug"
+)
+
+// RequestIDHeader はリクエストIDを受け渡しするヘッダー
+const RequestIDHeader = "X-Request-ID"
+
+// maxRequestIDLength はクライアントから受け付けるリクエストIDの最大長
+const maxRequestIDLength = 128
+
+type requestIDKey struct{}
+
+// RequestIDFromContext はctxに保存されたリクエストIDを返す（未設定の場合は空文字）
+func RequestIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// RequestIDMiddleware はリクエスト毎にIDを割り当て、コンテキストとレスポンスヘッダーに設定するミドルウェア
+// クライアントがX-Request-IDを送ってきた場合は妥当な値であればそれを引き継ぐ
+func RequestIDMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.Header.Get(RequestIDHeader)
+		if !isValidRequestID(id) {
+			id = newRequestID()
+		}
+		w.Header().Set(RequestIDHeader, id)
+		ctx := context.WithValue(r.Context(), requestIDKey{}, id)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// RecoveryMiddleware はハンドラーのpanicを回復し、500のJSONエラーを返すミドルウェア
+func RecoveryMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer func() {
+			rec := recover()
+			if rec == nil {
+				return
+			}
+			// ハンドラーが意図的に中断した場合はnet/httpの挙動に任せる
+			if rec == http.ErrAbortHandler {
+				panic(rec)
+			}
+			log.Printf("[%s] %s - Panic (request_id=%s): %v\n%s",
+				r.Method, r.URL.Path, RequestIDFromContext(r.Context()), rec, debug.Stack())
+			WriteError(w, r, NewInternalServerError("Internal Server Error"))
+		}()
+		next.ServeHTTP(w, r)
+	})
+}
+
+// newRequestID はランダムな16バイトのリクエストIDを生成
+func newRequestID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "unknown"
+	}
+	return hex.EncodeToString(b)
+}
+
+// isValidRequestID はクライアント指定のリクエストIDがログやヘッダーに安全に使えるかを判定
+func isValidRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for _, c := range id {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9', c == '-', c == '_', c == '.':
+		default:
+			return false
+		}
+	}
+	return true
+}
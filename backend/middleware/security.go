@@ -13,6 +13,7 @@ type SecurityConfig struct {
 	AllowedOrigins   []string
 	AllowedMethods   []string
 	AllowedHeaders   []string
+	ExposedHeaders   []string // フロントエンドのJavaScriptから参照可能にするレスポンスヘッダー
 	AllowCredentials bool
 
 	// セキュリティヘッダー設定
@@ -44,7 +45,11 @@ func DefaultSecurityConfig() *SecurityConfig {
 		},
 		AllowedHeaders: []string{
 			"Origin", "Content-Type", "Accept", "Authorization",
-			"X-Requested-With", "X-HTTP-Method-Override",
+			"X-Requested-With", "X-HTTP-Method-Override", RequestIDHeader,
+		},
+		ExposedHeaders: []string{
+			StaleDataHeader, // 古いキャッシュを返したことをフロントエンドに通知
+			RequestIDHeader, // 問い合わせ時にリクエストを特定できるようにする
 		},
 		AllowCredentials: true,
 
@@ -107,6 +112,9 @@ func setCORSHeaders(w http.ResponseWriter, r *http.Request, config *SecurityConf
 	// その他のCORSヘッダー
 	w.Header().Set("Access-Control-Allow-Methods", strings.Join(config.AllowedMethods, ", "))
 	w.Header().Set("Access-Control-Allow-Headers", strings.Join(config.AllowedHeaders, ", "))
+	if len(config.ExposedHeaders) > 0 {
+		w.Header().Set("Access-Control-Expose-Headers", strings.Join(config.ExposedHeaders, ", "))
+	}
 
 	if config.AllowCredentials {
 		w.Header().Set("Access-Control-Allow-Credentials", "true")
@@ -121,7 +129,7 @@ func handlePreflight(w http.ResponseWriter, r *http.Request, config *SecurityCon
 	// プリフライトリクエストで要求されたメソッドをチェック
 	requestedMethod := r.Header.Get("Access-Control-Request-Method")
 	if requestedMethod != "" && !isMethodAllowed(requestedMethod, config.AllowedMethods) {
-		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		WriteError(w, r, NewAPIError(http.StatusMethodNotAllowed, "Method not allowed: "+requestedMethod).WithCode("CORS_METHOD_NOT_ALLOWED"))
 		return
 	}
 
@@ -132,7 +140,7 @@ func handlePreflight(w http.ResponseWriter, r *http.Request, config *SecurityCon
 		for _, header := range headers {
 			header = strings.TrimSpace(header)
 			if !isHeaderAllowed(header, config.AllowedHeaders) {
-				http.Error(w, "Header not allowed", http.StatusForbidden)
+				WriteError(w, r, NewAPIError(http.StatusForbidden, "Header not allowed: "+header).WithCode("CORS_HEADER_NOT_ALLOWED"))
 				return
 			}
 		}
@@ -0,0 +1,45 @@
+package middleware
+
+import (
+	"net/http"
+
+	"go-movie-explorer/services"
+)
+
+// StaleDataHeader はTMDB障害により古いキャッシュを返したレスポンスに付与するヘッダー
+// フロントエンドはこのヘッダーを見て「データが古い可能性があります」の表示を行う
+const StaleDataHeader = "X-Data-Stale"
+
+// StaleDataMiddleware はリクエスト毎にStaleTrackerを用意し、
+// サービス層が古いキャッシュを返した場合にレスポンスへStaleDataHeaderを付与するミドルウェア
+func StaleDataMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, tracker := services.WithStaleTracker(r.Context())
+		sw := &staleDataWriter{ResponseWriter: w, tracker: tracker}
+		next.ServeHTTP(sw, r.WithContext(ctx))
+	})
+}
+
+// staleDataWriter はヘッダー送信直前にStaleTrackerを確認するResponseWriter
+type staleDataWriter struct {
+	http.ResponseWriter
+	tracker     *services.StaleTracker
+	wroteHeader bool
+}
+
+func (w *staleDataWriter) WriteHeader(statusCode int) {
+	if !w.wroteHeader {
+		w.wroteHeader = true
+		if w.tracker.Degraded() {
+			w.Header().Set(StaleDataHeader, "true")
+		}
+	}
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (w *staleDataWriter) Write(b []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	return w.ResponseWriter.Write(b)
+}
@@ -0,0 +1,29 @@
+package models
+
+// コレクション（シリーズ作品）取得API用モデル（/collection/{id}）
+type TmdbCollectionResponse struct {
+	ID           int         `json:"id"`
+	Name         string      `json:"name"`
+	Overview     string      `json:"overview"`
+	PosterPath   string      `json:"poster_path"`
+	BackdropPath string      `json:"backdrop_path"`
+	Parts        []TmdbMovie `json:"parts"`
+}
+
+// コレクションのレスポンス構造体
+type Collection struct {
+	ID           int     `json:"id"`
+	Name         string  `json:"name"`
+	Overview     string  `json:"overview"`
+	PosterPath   string  `json:"poster_path"`
+	BackdropPath string  `json:"backdrop_path"`
+	Parts        []Movie `json:"parts"` // 公開日の古い順（公開日未定の作品は末尾）
+}
+
+// 映画詳細に含めるコレクション情報（「ロード・オブ・ザ・リング シリーズの2作目」の表示用）
+// シリーズ中の位置はinclude=collection指定時のみ設定される
+type MovieCollection struct {
+	BelongsToCollection
+	Part       int `json:"part,omitempty"`        // シリーズ中の何作目か（1始まり）
+	TotalParts int `json:"total_parts,omitempty"` // シリーズの作品数
+}
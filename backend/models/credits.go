@@ -0,0 +1,40 @@
+package models
+
+// 出演者・スタッフ取得API用モデル（/movie/{id}/credits）
+
+// 出演者（TMDBのcastをそのままデコード）
+type CastMember struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	Character   string `json:"character"`
+	Order       int    `json:"order"`
+	ProfilePath string `json:"profile_path"`
+	CreditID    string `json:"credit_id"`
+}
+
+// スタッフ（TMDBのcrewをそのままデコード）
+type CrewMember struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	Job         string `json:"job"`
+	Department  string `json:"department"`
+	ProfilePath string `json:"profile_path"`
+	CreditID    string `json:"credit_id"`
+}
+
+// TMDBの出演者・スタッフAPIのレスポンス
+type TmdbCreditsResponse struct {
+	ID   int          `json:"id"`
+	Cast []CastMember `json:"cast"`
+	Crew []CrewMember `json:"crew"`
+}
+
+// 出演者・スタッフのレスポンス構造体
+// スタッフは部署（Directing, Writing, ...）毎にまとめ、監督・脚本家はトップレベルにも含める
+type Credits struct {
+	MovieID   int                     `json:"movie_id"`
+	Cast      []CastMember            `json:"cast"`
+	Crew      map[string][]CrewMember `json:"crew"`
+	Directors []CrewMember            `json:"directors"`
+	Writers   []CrewMember            `json:"writers"`
+}
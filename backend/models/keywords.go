@@ -0,0 +1,27 @@
+package models
+
+// キーワード（「タイムトラベル」「強盗」などのタグ。名前は英語のみ）
+type Keyword struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// 映画のキーワード取得API用モデル（/movie/{id}/keywords）
+type TmdbMovieKeywordsResponse struct {
+	ID       int       `json:"id"`
+	Keywords []Keyword `json:"keywords"`
+}
+
+// 映画のキーワードのレスポンス構造体
+type MovieKeywords struct {
+	MovieID  int       `json:"movie_id"`
+	Keywords []Keyword `json:"keywords"`
+}
+
+// キーワード検索のレスポンス構造体（/search/keywordのレスポンスと同じ形式）
+type KeywordSearchResponse struct {
+	Page         int       `json:"page"`
+	TotalPages   int       `json:"total_pages"`
+	TotalResults int       `json:"total_results"`
+	Results      []Keyword `json:"results"`
+}
@@ -0,0 +1,17 @@
+package models
+
+// 上映中・公開予定作品の対象期間（TMDBのdates。YYYY-MM-DD）
+type DateRange struct {
+	Minimum string `json:"minimum"`
+	Maximum string `json:"maximum"`
+}
+
+// 上映中・公開予定・高評価作品一覧のレスポンス構造体（/movie/now_playing, /movie/upcoming, /movie/top_rated）
+// 高評価作品にはdatesが無いため省略する
+type DatedMoviesResponse struct {
+	Page         int        `json:"page"`
+	TotalPages   int        `json:"total_pages"`
+	TotalResults int        `json:"total_results"`
+	Results      []Movie    `json:"results"`
+	Dates        *DateRange `json:"dates,omitempty"`
+}
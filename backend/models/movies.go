@@ -18,6 +18,20 @@ type MoviesResponse struct {
 	Results      []Movie `json:"results"`
 }
 
+// TMDBの映画一覧の1件（成人向けかどうかで絞り込むためadultも受け取る）
+type TmdbMovie struct {
+	Movie
+	Adult bool `json:"adult"`
+}
+
+// TMDBの映画一覧レスポンス（/movie/{id}/recommendations, /movie/{id}/similar 等）
+type TmdbMoviesResponse struct {
+	Page         int         `json:"page"`
+	TotalPages   int         `json:"total_pages"`
+	TotalResults int         `json:"total_results"`
+	Results      []TmdbMovie `json:"results"`
+}
+
 
 // 映画詳細取得API用モデル（/discover/movie/{id}）
 type BelongsToCollection struct {
@@ -27,6 +41,27 @@ type BelongsToCollection struct {
 	BackdropPath string `json:"backdrop_path"`
 }
 
+// 話されている言語
+type SpokenLanguage struct {
+	ISO639_1    string `json:"iso_639_1"`
+	Name        string `json:"name"`         // 現地語での名称（例: 日本語）
+	EnglishName string `json:"english_name"` // 英語での名称（例: Japanese）
+}
+
+// 制作会社
+type ProductionCompany struct {
+	ID            int    `json:"id"`
+	Name          string `json:"name"`
+	LogoPath      string `json:"logo_path"`
+	OriginCountry string `json:"origin_country"`
+}
+
+// 制作国
+type ProductionCountry struct {
+	ISO3166_1 string `json:"iso_3166_1"`
+	Name      string `json:"name"`
+}
+
 type TmdbMovieDetailResponse struct {
 	Adult               bool                 `json:"adult"`
 	BackdropPath        string               `json:"backdrop_path"`
@@ -42,8 +77,20 @@ type TmdbMovieDetailResponse struct {
 	Overview            string               `json:"overview"`
 	Popularity          float64              `json:"popularity"`
 	PosterPath          string               `json:"poster_path"`
+	ProductionCompanies []ProductionCompany  `json:"production_companies"`
+	ProductionCountries []ProductionCountry  `json:"production_countries"`
 	ReleaseDate         string               `json:"release_date"`
+	Revenue             int                  `json:"revenue"`
+	Runtime             int                  `json:"runtime"`
+	SpokenLanguages     []SpokenLanguage     `json:"spoken_languages"`
+	Status              string               `json:"status"`
+	Tagline             string               `json:"tagline"`
 	Title               string               `json:"title"`
+	VoteAverage         float64              `json:"vote_average"`
+	VoteCount           int                  `json:"vote_count"`
+
+	// append_to_response=release_dates
+	ReleaseDates TmdbReleaseDatesResponse `json:"release_dates"`
 }
 
 type MovieDetail struct {
@@ -61,6 +108,31 @@ type MovieDetail struct {
 	Budget           int      `json:"budget"`
 	OriginCountry    []string `json:"origin_country"`
 	OriginalLanguage string   `json:"original_language"`
+
+	Adult               bool                `json:"adult"`
+	Runtime             int                 `json:"runtime"` // 上映時間（分）。不明の場合は0
+	Revenue             int                 `json:"revenue"` // 興行収入（USD）。不明の場合は0
+	Tagline             string              `json:"tagline"`
+	Status              string              `json:"status"` // Rumored / Planned / In Production / Post Production / Released / Canceled
+	VoteAverage         float64             `json:"vote_average"`
+	VoteCount           int                 `json:"vote_count"`
+	SpokenLanguages     []SpokenLanguage    `json:"spoken_languages"`
+	ProductionCompanies []ProductionCompany `json:"production_companies"`
+	ProductionCountries []ProductionCountry `json:"production_countries"`
+
+	// シリーズ作品の場合のコレクション（シリーズでない場合はnull）
+	// include=collection指定時はシリーズ中の位置（part / total_parts）も含める
+	BelongsToCollection *MovieCollection `json:"belongs_to_collection"`
+
+	// リクエスト地域の年齢制限（例: 日本はG / PG12 / R15+ / R18+。地域やレーティングが無い場合は空文字）
+	Certification string `json:"certification"`
+
+	// リクエストの言語を優先して選んだ予告編（無い場合はnull）
+	Trailer *Video `json:"trailer"`
+
+	// include=credits / include=videos指定時のみ含める
+	Credits *Credits `json:"credits,omitempty"`
+	Videos  []Video  `json:"videos,omitempty"`
 }
 
 // ジャンル用モデル
@@ -98,7 +170,12 @@ type GenreMoviesResponse struct {
 
 // ジャンル別映画リストのレスポンス構造体
 type GenreMovieListResponse struct {
-	GenreID      int            `json:"genre_id"`
+	GenreID        int     `json:"genre_id"` // 先頭のジャンルID（単一ジャンル指定時との互換用）
+	GenreIDs       []int   `json:"genre_ids"`
+	Match          string  `json:"match"`           // all（全て含む） / any（いずれかを含む）
+	Genres         []Genre `json:"genres"`          // 指定したジャンル（名前はジャンル一覧から解決）
+	ExcludedGenres []Genre `json:"excluded_genres"` // 除外したジャンル
+
 	Page         int            `json:"page"`
 	PerPage      int            `json:"per_page"`
 	TotalPages   int            `json:"total_pages"`
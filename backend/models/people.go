@@ -0,0 +1,133 @@
+package models
+
+// 人物詳細取得API用モデル（/person/{id}?append_to_response=images）
+type TmdbPersonResponse struct {
+	ID                 int      `json:"id"`
+	Name               string   `json:"name"`
+	AlsoKnownAs        []string `json:"also_known_as"`
+	Biography          string   `json:"biography"`
+	Birthday           string   `json:"birthday"`
+	Deathday           string   `json:"deathday"`
+	PlaceOfBirth       string   `json:"place_of_birth"`
+	Gender             int      `json:"gender"`
+	KnownForDepartment string   `json:"known_for_department"`
+	Homepage           string   `json:"homepage"`
+	IMDBID             string   `json:"imdb_id"`
+	Popularity         float64  `json:"popularity"`
+	ProfilePath        string   `json:"profile_path"`
+	Images             struct {
+		Profiles []ProfileImage `json:"profiles"`
+	} `json:"images"`
+}
+
+// 人物のプロフィール画像
+type ProfileImage struct {
+	FilePath    string  `json:"file_path"`
+	Width       int     `json:"width"`
+	Height      int     `json:"height"`
+	AspectRatio float64 `json:"aspect_ratio"`
+}
+
+// 人物詳細のレスポンス構造体
+// 生年月日・没年月日が不明（存命）の場合は空文字
+type Person struct {
+	ID                 int            `json:"id"`
+	Name               string         `json:"name"`
+	AlsoKnownAs        []string       `json:"also_known_as"`
+	Biography          string         `json:"biography"`
+	Birthday           string         `json:"birthday"`
+	Deathday           string         `json:"deathday"`
+	PlaceOfBirth       string         `json:"place_of_birth"`
+	Gender             int            `json:"gender"`
+	KnownForDepartment string         `json:"known_for_department"`
+	Homepage           string         `json:"homepage"`
+	IMDBID             string         `json:"imdb_id"`
+	Popularity         float64        `json:"popularity"`
+	ProfilePath        string         `json:"profile_path"`
+	ProfileImages      []ProfileImage `json:"profile_images"`
+}
+
+// 人物の出演・参加作品API用モデル（/person/{id}/movie_credits）
+// castの場合はCharacter、crewの場合はJob・Departmentが設定される
+type TmdbPersonMovieCredit struct {
+	ID            int     `json:"id"`
+	Title         string  `json:"title"`
+	OriginalTitle string  `json:"original_title"`
+	ReleaseDate   string  `json:"release_date"`
+	PosterPath    string  `json:"poster_path"`
+	VoteAverage   float64 `json:"vote_average"`
+	VoteCount     int     `json:"vote_count"`
+	Popularity    float64 `json:"popularity"`
+	Character     string  `json:"character"`
+	Job           string  `json:"job"`
+	Department    string  `json:"department"`
+}
+
+type TmdbPersonMovieCredits struct {
+	ID   int                     `json:"id"`
+	Cast []TmdbPersonMovieCredit `json:"cast"`
+	Crew []TmdbPersonMovieCredit `json:"crew"`
+}
+
+// フィルモグラフィーの1作品（出演と監督を兼ねた作品などは1件にまとめる）
+type PersonMovie struct {
+	ID            int      `json:"id"`
+	Title         string   `json:"title"`
+	OriginalTitle string   `json:"original_title"`
+	ReleaseDate   string   `json:"release_date"`
+	PosterPath    string   `json:"poster_path"`
+	VoteAverage   float64  `json:"vote_average"`
+	VoteCount     int      `json:"vote_count"`
+	Popularity    float64  `json:"popularity"`
+	Character     string   `json:"character,omitempty"` // 出演時の役名
+	Jobs          []string `json:"jobs,omitempty"`      // スタッフとしての役職
+}
+
+// フィルモグラフィーのレスポンス構造体
+type PersonMoviesResponse struct {
+	PersonID     int           `json:"person_id"`
+	Sort         string        `json:"sort"`
+	TotalResults int           `json:"total_results"`
+	Results      []PersonMovie `json:"results"`
+}
+
+// 人物検索API用モデル（/search/person）
+type TmdbPeopleSearchResponse struct {
+	Page         int                      `json:"page"`
+	TotalPages   int                      `json:"total_pages"`
+	TotalResults int                      `json:"total_results"`
+	Results      []TmdbPersonSearchResult `json:"results"`
+}
+
+type TmdbPersonSearchResult struct {
+	ID                 int            `json:"id"`
+	Name               string         `json:"name"`
+	KnownForDepartment string         `json:"known_for_department"`
+	ProfilePath        string         `json:"profile_path"`
+	Popularity         float64        `json:"popularity"`
+	KnownFor           []TmdbKnownFor `json:"known_for"`
+}
+
+// 代表作（映画以外にTVも含まれるためmedia_typeで判別する）
+type TmdbKnownFor struct {
+	Movie
+	MediaType string `json:"media_type"`
+}
+
+// 人物検索結果の1件
+type PersonSummary struct {
+	ID                 int     `json:"id"`
+	Name               string  `json:"name"`
+	KnownForDepartment string  `json:"known_for_department"`
+	ProfilePath        string  `json:"profile_path"`
+	Popularity         float64 `json:"popularity"`
+	KnownFor           []Movie `json:"known_for"` // 代表作（映画のみ）
+}
+
+// 人物検索のレスポンス構造体
+type PeopleSearchResponse struct {
+	Page         int             `json:"page"`
+	TotalPages   int             `json:"total_pages"`
+	TotalResults int             `json:"total_results"`
+	Results      []PersonSummary `json:"results"`
+}
@@ -0,0 +1,36 @@
+package models
+
+// 配信サービス取得API用モデル（/movie/{id}/watch/providers）
+
+// 配信サービス（TMDBの値をそのままデコード）
+type WatchProvider struct {
+	ProviderID      int    `json:"provider_id"`
+	ProviderName    string `json:"provider_name"`
+	LogoPath        string `json:"logo_path"`
+	DisplayPriority int    `json:"display_priority"` // 小さいほど優先して表示する
+}
+
+// 地域毎の配信サービス
+type TmdbRegionWatchProviders struct {
+	Link     string          `json:"link"`
+	Flatrate []WatchProvider `json:"flatrate"`
+	Rent     []WatchProvider `json:"rent"`
+	Buy      []WatchProvider `json:"buy"`
+}
+
+// TMDBの配信サービスAPIのレスポンス（キーは地域コード）
+type TmdbWatchProvidersResponse struct {
+	ID      int                                 `json:"id"`
+	Results map[string]TmdbRegionWatchProviders `json:"results"`
+}
+
+// 配信サービスのレスポンス構造体
+// Flatrateは見放題、Rentはレンタル、Buyは購入（いずれもdisplay_priority順）
+type MovieWatchProviders struct {
+	MovieID  int             `json:"movie_id"`
+	Region   string          `json:"region"`
+	Link     string          `json:"link"` // TMDBの視聴方法ページ
+	Flatrate []WatchProvider `json:"flatrate"`
+	Rent     []WatchProvider `json:"rent"`
+	Buy      []WatchProvider `json:"buy"`
+}
@@ -0,0 +1,46 @@
+package models
+
+// 公開日・年齢制限取得API用モデル（/movie/{id}/release_dates）
+type TmdbReleaseDate struct {
+	Certification string   `json:"certification"`
+	Descriptors   []string `json:"descriptors"`
+	Language      string   `json:"iso_639_1"`
+	Note          string   `json:"note"`
+	ReleaseDate   string   `json:"release_date"` // 1999-10-15T00:00:00.000Z
+	Type          int      `json:"type"`         // 1:プレミア 2:限定公開 3:劇場公開 4:デジタル 5:パッケージ 6:TV
+}
+
+type TmdbCountryReleaseDates struct {
+	Country      string            `json:"iso_3166_1"`
+	ReleaseDates []TmdbReleaseDate `json:"release_dates"`
+}
+
+type TmdbReleaseDatesResponse struct {
+	ID      int                       `json:"id"`
+	Results []TmdbCountryReleaseDates `json:"results"`
+}
+
+// 公開・リリースの1件
+type Release struct {
+	Type          string   `json:"type"`          // premiere / theatrical_limited / theatrical / digital / physical / tv
+	ReleaseDate   string   `json:"release_date"`  // YYYY-MM-DD
+	Certification string   `json:"certification"` // 年齢制限（日本の場合は映倫のG / PG12 / R15+ / R18+）
+	Descriptors   []string `json:"descriptors"`
+	Language      string   `json:"iso_639_1"`
+	Note          string   `json:"note"`
+}
+
+// 国毎の公開・リリース（公開日の古い順）
+type CountryReleases struct {
+	Country       string    `json:"iso_3166_1"`
+	Certification string    `json:"certification"` // その国の代表的な年齢制限（劇場公開を優先）
+	Releases      []Release `json:"releases"`
+}
+
+// 公開日・年齢制限のレスポンス構造体
+type MovieReleases struct {
+	MovieID       int               `json:"movie_id"`
+	Region        string            `json:"region"`        // certificationの対象地域（未指定の場合は空文字）
+	Certification string            `json:"certification"` // リクエスト地域の年齢制限
+	Countries     []CountryReleases `json:"countries"`
+}
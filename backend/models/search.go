@@ -0,0 +1,55 @@
+package models
+
+// コレクション検索API用モデル（/search/collection）
+type TmdbCollectionSearchResponse struct {
+	Page         int                 `json:"page"`
+	TotalPages   int                 `json:"total_pages"`
+	TotalResults int                 `json:"total_results"`
+	Results      []CollectionSummary `json:"results"`
+}
+
+// コレクション検索結果の1件
+type CollectionSummary struct {
+	ID           int    `json:"id"`
+	Name         string `json:"name"`
+	Overview     string `json:"overview"`
+	PosterPath   string `json:"poster_path"`
+	BackdropPath string `json:"backdrop_path"`
+}
+
+// 横断検索結果の1件（media_typeで種類を判別し、種類毎に該当するフィールドのみ設定される）
+type SearchResult struct {
+	MediaType string `json:"media_type"` // movie / person / collection / keyword
+	ID        int    `json:"id"`
+	Title     string `json:"title,omitempty"` // movie
+	Name      string `json:"name,omitempty"`  // person / collection / keyword
+
+	Overview           string  `json:"overview,omitempty"`      // movie / collection
+	ReleaseDate        string  `json:"release_date,omitempty"`  // movie
+	PosterPath         string  `json:"poster_path,omitempty"`   // movie / collection
+	BackdropPath       string  `json:"backdrop_path,omitempty"` // collection
+	ProfilePath        string  `json:"profile_path,omitempty"`  // person
+	VoteAverage        float64 `json:"vote_average,omitempty"`  // movie
+	Popularity         float64 `json:"popularity,omitempty"`    // movie / person
+	KnownForDepartment string  `json:"known_for_department,omitempty"`
+	KnownFor           []Movie `json:"known_for,omitempty"` // person（代表作、映画のみ）
+}
+
+// 種類毎の検索結果の件数（タブ表示用）
+type SearchCounts struct {
+	Movie      int `json:"movie"`
+	Person     int `json:"person"`
+	Collection int `json:"collection"`
+	Keyword    int `json:"keyword"`
+}
+
+// 横断検索のレスポンス構造体
+type SearchResponse struct {
+	Page         int            `json:"page"`
+	TotalPages   int            `json:"total_pages"`
+	TotalResults int            `json:"total_results"`
+	Counts       SearchCounts   `json:"counts"`
+	Results      []SearchResult `json:"results"`
+
+	FailedTypes []string `json:"failed_types,omitempty"` // 検索に失敗し0件として扱った種類
+}
@@ -0,0 +1,32 @@
+package models
+
+// 動画取得API用モデル（/movie/{id}/videos）
+
+// 予告編・ティーザー・クリップ等の動画（TMDBのresultsをそのままデコード）
+type Video struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Key         string `json:"key"`  // 動画サイト上のID（YouTubeの場合は watch?v= の値）
+	Site        string `json:"site"` // YouTube / Vimeo
+	Type        string `json:"type"` // Trailer / Teaser / Clip 等
+	Size        int    `json:"size"`
+	Language    string `json:"iso_639_1"`
+	Country     string `json:"iso_3166_1"`
+	Official    bool   `json:"official"`
+	PublishedAt string `json:"published_at"`
+	URL         string `json:"url,omitempty"` // 再生ページのURL（対応サイトのみ）
+}
+
+// TMDBの動画APIのレスポンス
+type TmdbVideosResponse struct {
+	ID      int     `json:"id"`
+	Results []Video `json:"results"`
+}
+
+// 動画一覧のレスポンス構造体
+// BestTrailerはリクエストの言語を優先して選んだ予告編（無い場合はnull）
+type MovieVideos struct {
+	MovieID     int     `json:"movie_id"`
+	BestTrailer *Video  `json:"best_trailer"`
+	Results     []Video `json:"results"`
+}
@@ -0,0 +1,195 @@
+package services
+
+import (
+	"errors"
+	"log"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen はサーキットブレーカーが開いているためリクエストを遮断した場合のエラー
+var ErrCircuitOpen = errors.New("サーキットブレーカーが開いているためTMDBへのリクエストを遮断しました")
+
+// BreakerConfig はサーキットブレーカーの設定
+type BreakerConfig struct {
+	FailureRatio        float64       // この失敗率以上になったら開く（0〜1）
+	MinRequests         int           // 失敗率を判定するのに必要な集計期間内の最小リクエスト数
+	Window              time.Duration // 失敗率を集計する期間
+	OpenTimeout         time.Duration // 開いてから試行（half-open）を許可するまでの時間
+	HalfOpenMaxRequests int           // half-open時に同時に許可する試行リクエスト数
+}
+
+// DefaultBreakerConfig はデフォルトのサーキットブレーカー設定を返す
+func DefaultBreakerConfig() *BreakerConfig {
+	return &BreakerConfig{
+		FailureRatio:        0.5,
+		MinRequests:         10,
+		Window:              30 * time.Second,
+		OpenTimeout:         15 * time.Second,
+		HalfOpenMaxRequests: 1,
+	}
+}
+
+// BreakerState はサーキットブレーカーの状態
+type BreakerState int
+
+const (
+	BreakerClosed   BreakerState = iota // 通常状態（リクエストを通す）
+	BreakerOpen                         // 遮断状態（即座に失敗させる）
+	BreakerHalfOpen                     // 試行状態（一部のリクエストだけ通して回復を確認）
+)
+
+// String は/healthz等で表示する状態名を返す
+func (s BreakerState) String() string {
+	switch s {
+	case BreakerOpen:
+		return "OPEN"
+	case BreakerHalfOpen:
+		return "HALF_OPEN"
+	default:
+		return "CLOSED"
+	}
+}
+
+// breakerOutcome はリクエスト結果の分類
+type breakerOutcome int
+
+const (
+	outcomeSuccess breakerOutcome = iota // TMDBが正常に応答した（4xxを含む）
+	outcomeFailure                       // ネットワークエラー・タイムアウト・5xx・429
+	outcomeIgnored                       // 呼び出し元のキャンセル等、TMDBの状態と無関係
+)
+
+// CircuitBreaker はTMDBの障害時にリクエストを即座に失敗させ、タイムアウト待ちで
+// goroutineやコネクションを占有しないようにするサーキットブレーカー
+type CircuitBreaker struct {
+	mu     sync.Mutex
+	config *BreakerConfig
+	state  BreakerState
+
+	windowStart time.Time // 集計期間の開始時刻（closed時）
+	requests    int       // 集計期間内のリクエスト数
+	failures    int       // 集計期間内の失敗数
+
+	openedAt         time.Time // openになった時刻
+	halfOpenInFlight int       // half-open時に実行中の試行リクエスト数
+	generation       uint64    // 状態が変化する毎に増える世代（遷移前に許可したリクエストの結果を無視するため）
+
+	now func() time.Time // テストで時刻を差し替えるため
+}
+
+// NewCircuitBreaker はサーキットブレーカーを作成
+// configがnilの場合はDefaultBreakerConfigを使用
+func NewCircuitBreaker(config *BreakerConfig) *CircuitBreaker {
+	if config == nil {
+		config = DefaultBreakerConfig()
+	}
+	return &CircuitBreaker{
+		config:      config,
+		state:       BreakerClosed,
+		windowStart: time.Now(),
+		now:         time.Now,
+	}
+}
+
+// State は現在の状態を返す（openの待機時間を過ぎている場合はhalf-openを返す）
+func (b *CircuitBreaker) State() BreakerState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == BreakerOpen && b.now().Sub(b.openedAt) >= b.config.OpenTimeout {
+		return BreakerHalfOpen
+	}
+	return b.state
+}
+
+// breakerTicket はAllowで許可したリクエストの許可時点の世代と状態
+type breakerTicket struct {
+	generation uint64
+	probe      bool // half-openの試行リクエストとして許可された
+}
+
+// Allow はリクエストを通してよいかを判定する
+// nilを返した場合、呼び出し側は結果を返されたticketと共にrecordで必ず報告すること
+func (b *CircuitBreaker) Allow() (breakerTicket, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case BreakerOpen:
+		if b.now().Sub(b.openedAt) < b.config.OpenTimeout {
+			return breakerTicket{}, ErrCircuitOpen
+		}
+		b.setState(BreakerHalfOpen)
+		fallthrough
+	case BreakerHalfOpen:
+		if b.halfOpenInFlight >= b.config.HalfOpenMaxRequests {
+			return breakerTicket{}, ErrCircuitOpen
+		}
+		b.halfOpenInFlight++
+		return breakerTicket{generation: b.generation, probe: true}, nil
+	}
+	return breakerTicket{generation: b.generation}, nil
+}
+
+// record はAllowで許可したリクエストの結果を報告する
+// 許可した後に状態が変化していた場合（closed時に許可したリクエストがhalf-open中に完了した等）は無視する
+func (b *CircuitBreaker) record(ticket breakerTicket, outcome breakerOutcome) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if ticket.generation != b.generation {
+		return
+	}
+	if ticket.probe {
+		b.halfOpenInFlight--
+		switch outcome {
+		case outcomeSuccess:
+			b.setState(BreakerClosed)
+		case outcomeFailure:
+			b.setState(BreakerOpen)
+		}
+		return
+	}
+	if outcome == outcomeIgnored {
+		return
+	}
+
+	// 集計期間を過ぎていればリセット
+	now := b.now()
+	if now.Sub(b.windowStart) >= b.config.Window {
+		b.windowStart = now
+		b.requests = 0
+		b.failures = 0
+	}
+
+	b.requests++
+	if outcome == outcomeFailure {
+		b.failures++
+	}
+	if b.requests >= b.config.MinRequests &&
+		float64(b.failures)/float64(b.requests) >= b.config.FailureRatio {
+		b.setState(BreakerOpen)
+	}
+}
+
+// setState は状態を遷移させる（呼び出し側でロックを取得すること）
+func (b *CircuitBreaker) setState(state BreakerState) {
+	if b.state == state {
+		return
+	}
+	log.Printf("TMDBサーキットブレーカーの状態が変化しました: %s -> %s (失敗 %d/%d)", b.state, state, b.failures, b.requests)
+
+	b.state = state
+	b.generation++
+	switch state {
+	case BreakerOpen:
+		b.openedAt = b.now()
+	case BreakerHalfOpen:
+		b.halfOpenInFlight = 0
+	case BreakerClosed:
+		b.windowStart = b.now()
+		b.requests = 0
+		b.failures = 0
+	}
+}
@@ -0,0 +1,169 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newTestBreaker は時刻を差し替え可能なサーキットブレーカーを作成するヘルパー関数
+func newTestBreaker() (*CircuitBreaker, *time.Time) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	breaker := NewCircuitBreaker(&BreakerConfig{
+		FailureRatio:        0.5,
+		MinRequests:         4,
+		Window:              time.Minute,
+		OpenTimeout:         10 * time.Second,
+		HalfOpenMaxRequests: 1,
+	})
+	breaker.now = func() time.Time { return now }
+	breaker.windowStart = now
+	return breaker, &now
+}
+
+// TestCircuitBreaker_OpensOnFailureRatio - 失敗率が閾値を超えたら開くことのテスト
+func TestCircuitBreaker_OpensOnFailureRatio(t *testing.T) {
+	breaker, _ := newTestBreaker()
+
+	outcomes := []breakerOutcome{outcomeSuccess, outcomeFailure, outcomeSuccess}
+	for _, outcome := range outcomes {
+		ticket, err := breaker.Allow()
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		breaker.record(ticket, outcome)
+	}
+	if breaker.State() != BreakerClosed {
+		t.Fatalf("Expected CLOSED below MinRequests, got %s", breaker.State())
+	}
+
+	ticket, _ := breaker.Allow()
+	breaker.record(ticket, outcomeFailure)
+	if breaker.State() != BreakerOpen {
+		t.Fatalf("Expected OPEN at 50%% failure ratio, got %s", breaker.State())
+	}
+	if _, err := breaker.Allow(); !errors.Is(err, ErrCircuitOpen) {
+		t.Errorf("Expected ErrCircuitOpen while open, got %v", err)
+	}
+}
+
+// TestCircuitBreaker_IgnoredOutcomes - 呼び出し元のキャンセルは失敗率に含めないことのテスト
+func TestCircuitBreaker_IgnoredOutcomes(t *testing.T) {
+	breaker, _ := newTestBreaker()
+
+	for i := 0; i < 10; i++ {
+		ticket, _ := breaker.Allow()
+		breaker.record(ticket, outcomeIgnored)
+	}
+	if breaker.State() != BreakerClosed {
+		t.Errorf("Expected CLOSED when only ignored outcomes recorded, got %s", breaker.State())
+	}
+}
+
+// TestCircuitBreaker_HalfOpen - half-openでの試行と回復・再遮断のテスト
+func TestCircuitBreaker_HalfOpen(t *testing.T) {
+	breaker, now := newTestBreaker()
+	for i := 0; i < 4; i++ {
+		ticket, _ := breaker.Allow()
+		breaker.record(ticket, outcomeFailure)
+	}
+
+	// 待機時間経過後は1件だけ試行を許可
+	*now = now.Add(10 * time.Second)
+	if breaker.State() != BreakerHalfOpen {
+		t.Fatalf("Expected HALF_OPEN after OpenTimeout, got %s", breaker.State())
+	}
+	probe, err := breaker.Allow()
+	if err != nil {
+		t.Fatalf("Expected probe request to be allowed, got %v", err)
+	}
+	if _, err := breaker.Allow(); !errors.Is(err, ErrCircuitOpen) {
+		t.Errorf("Expected concurrent probe to be rejected, got %v", err)
+	}
+
+	// 試行が失敗したら再び開く
+	breaker.record(probe, outcomeFailure)
+	if breaker.State() != BreakerOpen {
+		t.Fatalf("Expected OPEN after failed probe, got %s", breaker.State())
+	}
+
+	// 試行が成功したら閉じる
+	*now = now.Add(10 * time.Second)
+	probe, err = breaker.Allow()
+	if err != nil {
+		t.Fatalf("Expected probe request to be allowed, got %v", err)
+	}
+	breaker.record(probe, outcomeSuccess)
+	if breaker.State() != BreakerClosed {
+		t.Errorf("Expected CLOSED after successful probe, got %s", breaker.State())
+	}
+}
+
+// TestCircuitBreaker_StaleGeneration - 状態の変化前に許可したリクエストの結果を無視することのテスト
+func TestCircuitBreaker_StaleGeneration(t *testing.T) {
+	breaker, now := newTestBreaker()
+
+	// closed時に許可したリクエストが、open→half-openに遷移した後で完了する
+	slow, err := breaker.Allow()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	for i := 0; i < 4; i++ {
+		ticket, _ := breaker.Allow()
+		breaker.record(ticket, outcomeFailure)
+	}
+	*now = now.Add(10 * time.Second)
+	probe, err := breaker.Allow()
+	if err != nil {
+		t.Fatalf("Expected probe request to be allowed, got %v", err)
+	}
+
+	// 試行ではないリクエストの成功でブレーカーを閉じたり試行枠を解放したりしない
+	breaker.record(slow, outcomeSuccess)
+	if breaker.State() != BreakerHalfOpen || breaker.halfOpenInFlight != 1 {
+		t.Fatalf("Expected HALF_OPEN with 1 probe in flight, got %s / %d", breaker.State(), breaker.halfOpenInFlight)
+	}
+	if _, err := breaker.Allow(); !errors.Is(err, ErrCircuitOpen) {
+		t.Errorf("Expected second probe to be rejected, got %v", err)
+	}
+
+	// 試行リクエストの結果は反映される
+	breaker.record(probe, outcomeSuccess)
+	if breaker.State() != BreakerClosed {
+		t.Errorf("Expected CLOSED after successful probe, got %s", breaker.State())
+	}
+
+	// 前のhalf-openで許可した試行の結果は閉じた後の集計に含めない
+	breaker.record(probe, outcomeFailure)
+	if breaker.requests != 0 || breaker.failures != 0 {
+		t.Errorf("Expected stale probe to be ignored, got %d/%d", breaker.failures, breaker.requests)
+	}
+}
+
+// TestTMDBClient_CircuitBreakerFailsFast - ブレーカーが開いている間はTMDBを呼ばないことのテスト
+func TestTMDBClient_CircuitBreakerFailsFast(t *testing.T) {
+	var requests atomic.Int32
+	client := newTestTMDBClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	client.breaker, _ = newTestBreaker()
+
+	for i := 0; i < 4; i++ {
+		client.GetMovies(context.Background(), 1, DiscoverOptions{})
+	}
+	if client.BreakerState() != "OPEN" {
+		t.Fatalf("Expected breaker to be OPEN, got %s", client.BreakerState())
+	}
+
+	_, err := client.GetMovies(context.Background(), 1, DiscoverOptions{})
+	if !errors.Is(err, ErrCircuitOpen) {
+		t.Errorf("Expected ErrCircuitOpen, got %v", err)
+	}
+	if requests.Load() != 4 {
+		t.Errorf("Expected no upstream request while open, got %d requests", requests.Load())
+	}
+}
@@ -0,0 +1,203 @@
+package services
+
+import (
+	"container/list"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// ResponseCache はTMDBレスポンスを保持するTTL付きのLRUキャッシュ
+// キーは上流リクエストのパス+クエリ（APIキーは含まない）
+// TTL切れのエントリもstaleRetentionの間は保持し、TMDB障害時の代替レスポンスとして使用する
+type ResponseCache struct {
+	mu             sync.Mutex
+	maxEntries     int
+	staleRetention time.Duration            // TTL切れ後もエントリを保持する期間
+	ll             *list.List               // 先頭が最も最近使われたエントリ
+	items          map[string]*list.Element // キー -> リスト要素
+
+	hits      atomic.Uint64
+	staleHits atomic.Uint64
+	misses    atomic.Uint64
+
+	now func() time.Time // テストで時刻を差し替えるため
+}
+
+// cacheEntry はキャッシュの1エントリ
+type cacheEntry struct {
+	key           string
+	value         []byte
+	expiresAt     time.Time
+	refreshFailed bool // TTL切れ後の再取得に失敗したか
+}
+
+// CachedResponse はLookupの結果
+type CachedResponse struct {
+	Value         []byte
+	Stale         bool          // TTL切れのエントリか
+	ExpiredFor    time.Duration // TTL切れからの経過時間（Staleの場合のみ）
+	RefreshFailed bool          // TTL切れ後の再取得に失敗しているか
+}
+
+// CacheStats はキャッシュの統計情報
+type CacheStats struct {
+	Hits       uint64 `json:"hits"`
+	StaleHits  uint64 `json:"stale_hits"`
+	Misses     uint64 `json:"misses"`
+	Entries    int    `json:"entries"`
+	MaxEntries int    `json:"max_entries"`
+}
+
+// NewResponseCache は最大maxEntries件を保持するキャッシュを作成
+// TTL切れのエントリはstaleRetentionの間だけLookupでstaleとして取得できる
+func NewResponseCache(maxEntries int, staleRetention time.Duration) *ResponseCache {
+	return &ResponseCache{
+		maxEntries:     maxEntries,
+		staleRetention: staleRetention,
+		ll:             list.New(),
+		items:          make(map[string]*list.Element),
+		now:            time.Now,
+	}
+}
+
+// Get はキーに対応する有効期限内の値を返す
+func (c *ResponseCache) Get(key string) ([]byte, bool) {
+	cached, ok := c.Lookup(key)
+	if !ok || cached.Stale {
+		return nil, false
+	}
+	return cached.Value, true
+}
+
+// Lookup はキーに対応するエントリを返す
+// TTL切れでも保持期間内であればStale=trueで返し、保持期間を過ぎたエントリは削除する
+func (c *ResponseCache) Lookup(key string) (CachedResponse, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.items[key]
+	if !ok {
+		c.misses.Add(1)
+		return CachedResponse{}, false
+	}
+
+	entry := elem.Value.(*cacheEntry)
+	expiredFor := c.now().Sub(entry.expiresAt)
+	if expiredFor >= c.staleRetention && expiredFor >= 0 {
+		// 保持期間を過ぎたエントリは削除してミス扱い
+		c.removeElement(elem)
+		c.misses.Add(1)
+		return CachedResponse{}, false
+	}
+
+	c.ll.MoveToFront(elem)
+	if expiredFor < 0 {
+		c.hits.Add(1)
+		return CachedResponse{Value: entry.value}, true
+	}
+
+	c.staleHits.Add(1)
+	return CachedResponse{
+		Value:         entry.value,
+		Stale:         true,
+		ExpiredFor:    expiredFor,
+		RefreshFailed: entry.refreshFailed,
+	}, true
+}
+
+// MarkRefreshFailed はTTL切れエントリの再取得に失敗したことを記録する
+func (c *ResponseCache) MarkRefreshFailed(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.items[key]; ok {
+		elem.Value.(*cacheEntry).refreshFailed = true
+	}
+}
+
+// Set は値をttlの間キャッシュする（上限を超えた場合は最も古いエントリを破棄）
+func (c *ResponseCache) Set(key string, value []byte, ttl time.Duration) {
+	if ttl <= 0 || c.maxEntries <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	expiresAt := c.now().Add(ttl)
+	if elem, ok := c.items[key]; ok {
+		entry := elem.Value.(*cacheEntry)
+		entry.value = value
+		entry.expiresAt = expiresAt
+		entry.refreshFailed = false
+		c.ll.MoveToFront(elem)
+		return
+	}
+
+	c.items[key] = c.ll.PushFront(&cacheEntry{key: key, value: value, expiresAt: expiresAt})
+	for c.ll.Len() > c.maxEntries {
+		c.removeElement(c.ll.Back())
+	}
+}
+
+// Delete は指定したキーのエントリを削除し、削除できたかを返す
+func (c *ResponseCache) Delete(key string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.items[key]
+	if !ok {
+		return false
+	}
+	c.removeElement(elem)
+	return true
+}
+
+// DeletePrefix はprefixで始まるキーのエントリを全て削除し、削除件数を返す
+func (c *ResponseCache) DeletePrefix(prefix string) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	deleted := 0
+	for key, elem := range c.items {
+		if strings.HasPrefix(key, prefix) {
+			c.removeElement(elem)
+			deleted++
+		}
+	}
+	return deleted
+}
+
+// Purge は全てのエントリを削除し、削除件数を返す
+func (c *ResponseCache) Purge() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	deleted := c.ll.Len()
+	c.ll.Init()
+	c.items = make(map[string]*list.Element)
+	return deleted
+}
+
+// Stats はヒット・staleヒット・ミス数と現在のエントリ数を返す
+func (c *ResponseCache) Stats() CacheStats {
+	c.mu.Lock()
+	entries := c.ll.Len()
+	c.mu.Unlock()
+
+	return CacheStats{
+		Hits:       c.hits.Load(),
+		StaleHits:  c.staleHits.Load(),
+		Misses:     c.misses.Load(),
+		Entries:    entries,
+		MaxEntries: c.maxEntries,
+	}
+}
+
+// removeElement はリストとマップからエントリを削除（呼び出し側でロックを取得すること）
+func (c *ResponseCache) removeElement(elem *list.Element) {
+	c.ll.Remove(elem)
+	delete(c.items, elem.Value.(*cacheEntry).key)
+}
@@ -0,0 +1,102 @@
+package services
+
+import (
+	"testing"
+	"time"
+)
+
+// newTestCache は時刻を差し替え可能なキャッシュを作成するヘルパー関数
+func newTestCache(maxEntries int) (*ResponseCache, *time.Time) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	cache := NewResponseCache(maxEntries, 0)
+	cache.now = func() time.Time { return now }
+	return cache, &now
+}
+
+// TestResponseCache_GetSet - 基本的な取得・保存とヒット/ミス数のテスト
+func TestResponseCache_GetSet(t *testing.T) {
+	cache, _ := newTestCache(10)
+
+	if _, ok := cache.Get("/genre/movie/list"); ok {
+		t.Error("Expected miss for empty cache")
+	}
+
+	cache.Set("/genre/movie/list", []byte(`{"genres":[]}`), time.Hour)
+	value, ok := cache.Get("/genre/movie/list")
+	if !ok {
+		t.Fatal("Expected hit after Set")
+	}
+	if string(value) != `{"genres":[]}` {
+		t.Errorf("Unexpected value: %s", value)
+	}
+
+	stats := cache.Stats()
+	if stats.Hits != 1 || stats.Misses != 1 || stats.Entries != 1 {
+		t.Errorf("Unexpected stats: %+v", stats)
+	}
+}
+
+// TestResponseCache_TTL - 有効期限切れのテスト
+func TestResponseCache_TTL(t *testing.T) {
+	cache, now := newTestCache(10)
+
+	cache.Set("/movie/550", []byte("detail"), time.Minute)
+
+	*now = now.Add(59 * time.Second)
+	if _, ok := cache.Get("/movie/550"); !ok {
+		t.Error("Expected hit before TTL expires")
+	}
+
+	*now = now.Add(time.Second)
+	if _, ok := cache.Get("/movie/550"); ok {
+		t.Error("Expected miss after TTL expires")
+	}
+	if cache.Stats().Entries != 0 {
+		t.Error("Expected expired entry to be removed")
+	}
+}
+
+// TestResponseCache_LRUEviction - 上限を超えた場合に最も古いエントリが破棄されることのテスト
+func TestResponseCache_LRUEviction(t *testing.T) {
+	cache, _ := newTestCache(2)
+
+	cache.Set("a", []byte("1"), time.Hour)
+	cache.Set("b", []byte("2"), time.Hour)
+	cache.Get("a") // aを最近使用したことにする
+	cache.Set("c", []byte("3"), time.Hour)
+
+	if _, ok := cache.Get("b"); ok {
+		t.Error("Expected least recently used entry 'b' to be evicted")
+	}
+	if _, ok := cache.Get("a"); !ok {
+		t.Error("Expected 'a' to remain")
+	}
+	if _, ok := cache.Get("c"); !ok {
+		t.Error("Expected 'c' to remain")
+	}
+}
+
+// TestResponseCache_Delete - キー・前方一致・全件削除のテスト
+func TestResponseCache_Delete(t *testing.T) {
+	cache, _ := newTestCache(10)
+	cache.Set("/movie/1", []byte("1"), time.Hour)
+	cache.Set("/movie/2", []byte("2"), time.Hour)
+	cache.Set("/discover/movie?page=1", []byte("3"), time.Hour)
+	cache.Set("/genre/movie/list", []byte("4"), time.Hour)
+
+	if !cache.Delete("/genre/movie/list") {
+		t.Error("Expected Delete to report success")
+	}
+	if cache.Delete("/genre/movie/list") {
+		t.Error("Expected second Delete to report failure")
+	}
+	if deleted := cache.DeletePrefix("/movie/"); deleted != 2 {
+		t.Errorf("Expected 2 entries deleted by prefix, got %d", deleted)
+	}
+	if deleted := cache.Purge(); deleted != 1 {
+		t.Errorf("Expected 1 entry deleted by purge, got %d", deleted)
+	}
+	if cache.Stats().Entries != 0 {
+		t.Error("Expected empty cache after purge")
+	}
+}
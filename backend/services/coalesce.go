@@ -0,0 +1,70 @@
+package services
+
+import (
+	"context"
+	"sync"
+)
+
+// inflightGroup は同一URLへの同時リクエストを1回の上流呼び出しにまとめる
+// 呼び出し元毎のキャンセルに対応し、全ての呼び出し元が離脱した場合のみ上流リクエストを中断する
+type inflightGroup struct {
+	mu    sync.Mutex
+	calls map[string]*inflightCall
+}
+
+// inflightCall は実行中の上流呼び出し
+type inflightCall struct {
+	done    chan struct{} // 完了時にclose
+	body    []byte
+	err     error
+	waiters int                // 結果を待っている呼び出し元の数
+	cancel  context.CancelFunc // 上流呼び出しのキャンセル
+}
+
+// Do はkeyに対する実行中の呼び出しがあれば結果を共有し、なければfnを実行する
+func (g *inflightGroup) Do(ctx context.Context, key string, fn func(ctx context.Context) ([]byte, error)) ([]byte, error) {
+	g.mu.Lock()
+	if g.calls == nil {
+		g.calls = make(map[string]*inflightCall)
+	}
+
+	call, running := g.calls[key]
+	if !running {
+		// 最初の呼び出し元のキャンセルが他の呼び出し元に波及しないよう、値のみ引き継いだコンテキストで実行
+		callCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+		call = &inflightCall{done: make(chan struct{}), cancel: cancel}
+		g.calls[key] = call
+
+		go func() {
+			body, err := fn(callCtx)
+			g.mu.Lock()
+			if g.calls[key] == call {
+				delete(g.calls, key)
+			}
+			g.mu.Unlock()
+
+			call.body, call.err = body, err
+			cancel()
+			close(call.done)
+		}()
+	}
+	call.waiters++
+	g.mu.Unlock()
+
+	select {
+	case <-call.done:
+		return call.body, call.err
+	case <-ctx.Done():
+		g.mu.Lock()
+		call.waiters--
+		if call.waiters == 0 {
+			// 誰も結果を待っていないため上流リクエストを中断し、以降の呼び出しは新規に実行させる
+			call.cancel()
+			if g.calls[key] == call {
+				delete(g.calls, key)
+			}
+		}
+		g.mu.Unlock()
+		return nil, ctx.Err()
+	}
+}
@@ -0,0 +1,146 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// waitersFor は実行中の呼び出しを待っている呼び出し元の数を返すヘルパー関数
+func waitersFor(g *inflightGroup, key string) int {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if call, ok := g.calls[key]; ok {
+		return call.waiters
+	}
+	return 0
+}
+
+// waitForWaiters は指定数の呼び出し元が合流するまで待つヘルパー関数
+func waitForWaiters(t *testing.T, g *inflightGroup, key string, n int) {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for waitersFor(g, key) < n {
+		if time.Now().After(deadline) {
+			t.Fatalf("Timed out waiting for %d waiters, got %d", n, waitersFor(g, key))
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+// TestTMDBClient_CoalesceConcurrentRequests - N件の同時リクエストが1回の上流呼び出しになることのテスト
+func TestTMDBClient_CoalesceConcurrentRequests(t *testing.T) {
+	const callers = 20
+	var upstreamRequests atomic.Int32
+	release := make(chan struct{})
+
+	client := newTestTMDBClient(t, func(w http.ResponseWriter, r *http.Request) {
+		upstreamRequests.Add(1)
+		<-release
+		w.Write([]byte(`{"id":550,"title":"Fight Club"}`))
+	})
+
+	var wg sync.WaitGroup
+	errs := make(chan error, callers)
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			detail, err := client.GetMovieDetail(context.Background(), 550)
+			if err == nil && detail.Title != "Fight Club" {
+				err = errors.New("unexpected title: " + detail.Title)
+			}
+			errs <- err
+		}()
+	}
+
+	// 全ての呼び出し元が合流してから上流のレスポンスを返す
+	waitForWaiters(t, &client.inflight, "/movie/550?append_to_response=release_dates", callers)
+	close(release)
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Errorf("Unexpected error: %v", err)
+		}
+	}
+	if got := upstreamRequests.Load(); got != 1 {
+		t.Errorf("Expected 1 upstream request for %d callers, got %d", callers, got)
+	}
+}
+
+// TestInflightGroup_CallerCancel - 1人の呼び出し元のキャンセルが他の呼び出し元に影響しないことのテスト
+func TestInflightGroup_CallerCancel(t *testing.T) {
+	var g inflightGroup
+	release := make(chan struct{})
+	var calls atomic.Int32
+	fn := func(ctx context.Context) ([]byte, error) {
+		calls.Add(1)
+		select {
+		case <-release:
+			return []byte("ok"), nil
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	cancelCtx, cancel := context.WithCancel(context.Background())
+	canceledErr := make(chan error, 1)
+	go func() {
+		_, err := g.Do(cancelCtx, "key", fn)
+		canceledErr <- err
+	}()
+	waitForWaiters(t, &g, "key", 1)
+
+	result := make(chan []byte, 1)
+	go func() {
+		body, _ := g.Do(context.Background(), "key", fn)
+		result <- body
+	}()
+	waitForWaiters(t, &g, "key", 2)
+
+	cancel()
+	if err := <-canceledErr; !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled for canceled caller, got %v", err)
+	}
+
+	close(release)
+	if body := <-result; string(body) != "ok" {
+		t.Errorf("Expected remaining caller to receive result, got %q", body)
+	}
+	if calls.Load() != 1 {
+		t.Errorf("Expected 1 call, got %d", calls.Load())
+	}
+}
+
+// TestInflightGroup_AllCallersCancel - 全ての呼び出し元が離脱した場合に上流呼び出しが中断されることのテスト
+func TestInflightGroup_AllCallersCancel(t *testing.T) {
+	var g inflightGroup
+	upstreamCanceled := make(chan struct{})
+	fn := func(ctx context.Context) ([]byte, error) {
+		<-ctx.Done()
+		close(upstreamCanceled)
+		return nil, ctx.Err()
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		g.Do(ctx, "key", fn)
+		close(done)
+	}()
+	waitForWaiters(t, &g, "key", 1)
+	cancel()
+	<-done
+
+	select {
+	case <-upstreamCanceled:
+	case <-time.After(time.Second):
+		t.Error("Expected upstream call to be canceled")
+	}
+}
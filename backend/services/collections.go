@@ -0,0 +1,100 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+
+	"go-movie-explorer/models"
+)
+
+// --- コレクション（シリーズ作品）取得（/collection/{id}）---
+func (c *TMDBClient) GetCollection(ctx context.Context, id int) (*models.Collection, error) {
+	path := fmt.Sprintf("/collection/%d", id)
+	var tmdbResp models.TmdbCollectionResponse
+	if err := c.get(ctx, EndpointCollection, path, nil, &tmdbResp); err != nil {
+		return nil, err
+	}
+
+	parts := make([]models.Movie, 0, len(tmdbResp.Parts))
+	for _, part := range tmdbResp.Parts {
+		parts = append(parts, part.Movie)
+	}
+
+	// 翻訳された概要が無い場合は英語の概要で補完（/collectionの一覧はresultsではなくpartsのため個別に処理）
+	if fallbackCtx, ok := c.overviewFallbackContext(ctx); ok && collectionOverviewMissing(&tmdbResp) {
+		var english models.TmdbCollectionResponse
+		if err := c.get(fallbackCtx, EndpointCollection, path, nil, &english); err != nil {
+			log.Printf("英語の概要の取得に失敗 (%s): %v", path, err)
+		} else {
+			if tmdbResp.Overview == "" {
+				tmdbResp.Overview = english.Overview
+			}
+			overviews := make(map[int]string, len(english.Parts))
+			for _, part := range english.Parts {
+				overviews[part.ID] = part.Overview
+			}
+			for i := range parts {
+				if parts[i].Overview == "" {
+					parts[i].Overview = overviews[parts[i].ID]
+				}
+			}
+		}
+	}
+
+	sortByReleaseDate(parts)
+	return &models.Collection{
+		ID:           tmdbResp.ID,
+		Name:         tmdbResp.Name,
+		Overview:     tmdbResp.Overview,
+		PosterPath:   tmdbResp.PosterPath,
+		BackdropPath: tmdbResp.BackdropPath,
+		Parts:        parts,
+	}, nil
+}
+
+// collectionOverviewMissing はコレクションまたはいずれかの作品の概要が空かどうか
+func collectionOverviewMissing(tmdbResp *models.TmdbCollectionResponse) bool {
+	if tmdbResp.Overview == "" {
+		return true
+	}
+	for _, part := range tmdbResp.Parts {
+		if part.Overview == "" {
+			return true
+		}
+	}
+	return false
+}
+
+// sortByReleaseDate は映画を公開日の古い順に並べる（公開日未定の作品は末尾）
+func sortByReleaseDate(movies []models.Movie) {
+	sort.SliceStable(movies, func(i, j int) bool {
+		a, b := movies[i], movies[j]
+		if (a.ReleaseDate == "") != (b.ReleaseDate == "") {
+			return b.ReleaseDate == ""
+		}
+		return a.ReleaseDate < b.ReleaseDate
+	})
+}
+
+// newMovieCollection は映画詳細に含めるコレクション情報を作る（シリーズでない場合はnil）
+// シリーズ中の位置はSetCollectionPositionで別途設定する
+func newMovieCollection(belongsTo *models.BelongsToCollection) *models.MovieCollection {
+	if belongsTo == nil {
+		return nil
+	}
+	return &models.MovieCollection{BelongsToCollection: *belongsTo}
+}
+
+// SetCollectionPosition はGetCollectionで取得したコレクションから映画のシリーズ中の位置を設定する
+// コレクションに映画が含まれない場合、partは0のまま
+func SetCollectionPosition(movieCollection *models.MovieCollection, collection *models.Collection, movieID int) {
+	movieCollection.TotalParts = len(collection.Parts)
+	for i, part := range collection.Parts {
+		if part.ID == movieID {
+			movieCollection.Part = i + 1
+			return
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package services
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"go-movie-explorer/models"
+)
+
+// collectionFixture はTMDBの/collection/{id}のレスポンス例（partsは公開日順とは限らない）
+const collectionFixture = `{"id": 119, "name": "ロード・オブ・ザ・リング（シリーズ）", "overview": "", "poster_path": "/p.jpg", "backdrop_path": "/b.jpg", "parts": [
+	{"id": 122, "title": "王の帰還", "overview": "3作目", "release_date": "2003-12-01"},
+	{"id": 999, "title": "新作", "overview": "", "release_date": ""},
+	{"id": 120, "title": "旅の仲間", "overview": "", "release_date": "2001-12-18"},
+	{"id": 121, "title": "二つの塔", "overview": "2作目", "release_date": "2002-12-18"}
+]}`
+
+// TestTMDBClient_GetCollection - 公開日順の並び替えと英語の概要での補完のテスト
+func TestTMDBClient_GetCollection(t *testing.T) {
+	client := newTestTMDBClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/collection/119" {
+			t.Errorf("Unexpected path: %s", r.URL.Path)
+		}
+		if r.URL.Query().Get("language") == "en-US" {
+			w.Write([]byte(`{"id": 119, "overview": "English collection overview", "parts": [{"id": 120, "overview": "English 1"}, {"id": 999, "overview": ""}]}`))
+			return
+		}
+		w.Write([]byte(collectionFixture))
+	})
+
+	ctx := WithLocale(context.Background(), Locale{Language: "ja"})
+	collection, err := client.GetCollection(ctx, 119)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var ids []int
+	for _, part := range collection.Parts {
+		ids = append(ids, part.ID)
+	}
+	if len(ids) != 4 || ids[0] != 120 || ids[1] != 121 || ids[2] != 122 || ids[3] != 999 {
+		t.Errorf("Expected parts in release order with undated last, got %v", ids)
+	}
+	if collection.Overview != "English collection overview" || collection.Parts[0].Overview != "English 1" || collection.Parts[1].Overview != "2作目" {
+		t.Errorf("Expected only empty overviews to be filled, got %q / %+v", collection.Overview, collection.Parts)
+	}
+}
+
+// TestSetCollectionPosition - 公開日順のコレクションからシリーズ中の位置を求めることのテスト
+func TestSetCollectionPosition(t *testing.T) {
+	client := newTestTMDBClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(collectionFixture))
+	})
+	collection, err := client.GetCollection(context.Background(), 119)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	movieCollection := newMovieCollection(&models.BelongsToCollection{ID: 119})
+	SetCollectionPosition(movieCollection, collection, 121)
+	if movieCollection.Part != 2 || movieCollection.TotalParts != 4 {
+		t.Errorf("Expected part 2 of 4, got %+v", movieCollection)
+	}
+
+	// コレクションに含まれない映画は位置なし
+	movieCollection = newMovieCollection(&models.BelongsToCollection{ID: 119})
+	SetCollectionPosition(movieCollection, collection, 1)
+	if movieCollection.Part != 0 || movieCollection.TotalParts != 4 {
+		t.Errorf("Expected no part, got %+v", movieCollection)
+	}
+
+	if newMovieCollection(nil) != nil {
+		t.Error("Expected nil for movie without collection")
+	}
+}
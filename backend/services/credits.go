@@ -0,0 +1,52 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"go-movie-explorer/models"
+)
+
+// スタッフの部署・役職名（TMDBの値）
+const (
+	jobDirector       = "Director"
+	departmentWriting = "Writing"
+)
+
+// --- 出演者・スタッフ取得（/movie/{id}/credits）---
+func (c *TMDBClient) GetMovieCredits(ctx context.Context, id int) (*models.Credits, error) {
+	var tmdbResp models.TmdbCreditsResponse
+	if err := c.get(ctx, EndpointCredits, fmt.Sprintf("/movie/%d/credits", id), nil, &tmdbResp); err != nil {
+		return nil, err
+	}
+	return newCredits(id, &tmdbResp), nil
+}
+
+// newCredits はTMDBのレスポンスを部署毎にまとめたCreditsに変換
+func newCredits(movieID int, tmdbResp *models.TmdbCreditsResponse) *models.Credits {
+	cast := append([]models.CastMember{}, tmdbResp.Cast...)
+	sort.SliceStable(cast, func(i, j int) bool { return cast[i].Order < cast[j].Order })
+
+	credits := &models.Credits{
+		MovieID:   movieID,
+		Cast:      cast,
+		Crew:      map[string][]models.CrewMember{},
+		Directors: []models.CrewMember{},
+		Writers:   []models.CrewMember{},
+	}
+
+	// 脚本家は「脚本」「原作」など複数の役職で重複するため人物単位でまとめる
+	writerSeen := map[int]bool{}
+	for _, member := range tmdbResp.Crew {
+		credits.Crew[member.Department] = append(credits.Crew[member.Department], member)
+		switch {
+		case member.Job == jobDirector:
+			credits.Directors = append(credits.Directors, member)
+		case member.Department == departmentWriting && !writerSeen[member.ID]:
+			writerSeen[member.ID] = true
+			credits.Writers = append(credits.Writers, member)
+		}
+	}
+	return credits
+}
@@ -0,0 +1,53 @@
+package services
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+// TestTMDBClient_GetMovieCredits - 出演者の並び順とスタッフの部署別・監督・脚本家の抽出のテスト
+func TestTMDBClient_GetMovieCredits(t *testing.T) {
+	var gotPath string
+	client := newTestTMDBClient(t, func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		w.Write([]byte(`{
+			"id": 550,
+			"cast": [
+				{"id": 2, "name": "Edward Norton", "character": "The Narrator", "order": 1, "profile_path": "/norton.jpg"},
+				{"id": 1, "name": "Brad Pitt", "character": "Tyler Durden", "order": 0, "profile_path": "/pitt.jpg"}
+			],
+			"crew": [
+				{"id": 10, "name": "David Fincher", "job": "Director", "department": "Directing"},
+				{"id": 11, "name": "Assistant", "job": "First Assistant Director", "department": "Directing"},
+				{"id": 20, "name": "Chuck Palahniuk", "job": "Novel", "department": "Writing"},
+				{"id": 21, "name": "Jim Uhls", "job": "Screenplay", "department": "Writing"},
+				{"id": 20, "name": "Chuck Palahniuk", "job": "Story", "department": "Writing"},
+				{"id": 30, "name": "Composer", "job": "Original Music Composer", "department": "Sound"}
+			]
+		}`))
+	})
+
+	credits, err := client.GetMovieCredits(context.Background(), 550)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if gotPath != "/movie/550/credits" {
+		t.Errorf("Expected path /movie/550/credits, got %s", gotPath)
+	}
+	if credits.MovieID != 550 {
+		t.Errorf("Expected movie_id 550, got %d", credits.MovieID)
+	}
+	if len(credits.Cast) != 2 || credits.Cast[0].Name != "Brad Pitt" || credits.Cast[0].Character != "Tyler Durden" {
+		t.Errorf("Expected cast sorted by order, got %+v", credits.Cast)
+	}
+	if len(credits.Directors) != 1 || credits.Directors[0].Name != "David Fincher" {
+		t.Errorf("Unexpected directors: %+v", credits.Directors)
+	}
+	if len(credits.Writers) != 2 || credits.Writers[0].ID != 20 || credits.Writers[1].ID != 21 {
+		t.Errorf("Expected writers deduplicated by person, got %+v", credits.Writers)
+	}
+	if len(credits.Crew["Directing"]) != 2 || len(credits.Crew["Writing"]) != 3 || len(credits.Crew["Sound"]) != 1 {
+		t.Errorf("Unexpected crew grouping: %+v", credits.Crew)
+	}
+}
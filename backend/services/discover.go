@@ -0,0 +1,108 @@
+package services
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// DiscoverSorts は映画一覧（/discover/movie）で指定できる並び順
+var DiscoverSorts = []string{
+	"popularity.desc", "popularity.asc",
+	"primary_release_date.desc", "primary_release_date.asc",
+	"vote_average.desc", "vote_average.asc",
+	"vote_count.desc", "vote_count.asc",
+	"revenue.desc", "revenue.asc",
+	"title.asc", "title.desc",
+}
+
+// IDFilter はジャンル・キーワード等のIDによる絞り込み条件
+// Anyがfalseの場合は全てを含む作品（TMDBの","区切り）、trueの場合はいずれかを含む作品（"|"区切り）
+type IDFilter struct {
+	IDs []int
+	Any bool
+}
+
+// String はTMDBのクエリパラメータ形式（28,12 または 28|12）に変換
+func (f IDFilter) String() string {
+	if f.Any {
+		return joinInts(f.IDs, "|")
+	}
+	return joinInts(f.IDs, ",")
+}
+
+// DiscoverOptions は映画一覧（/discover/movie）の絞り込み条件（ゼロ値の項目は指定なし）
+type DiscoverOptions struct {
+	SortBy string // DiscoverSortsのいずれか
+
+	// 公開年、または公開日の範囲（YYYY-MM-DD）
+	Year            int
+	ReleaseDateFrom string
+	ReleaseDateTo   string
+
+	MinVoteAverage float64
+	MinVoteCount   int
+	MinRuntime     int // 上映時間（分）
+	MaxRuntime     int
+
+	OriginalLanguage string // ISO 639-1（例: ja）
+
+	Genres        IDFilter
+	ExcludeGenres []int
+	Keywords      IDFilter
+	Companies     IDFilter
+
+	WatchProviders    IDFilter // 配信サービスで視聴できる作品に絞る（地域が必要）
+	MonetizationTypes []string // 視聴方法（flatrate / rent / buy 等）
+}
+
+// apply は絞り込み条件を/discover/movieのクエリパラメータに設定する
+// regionは配信サービスでの絞り込みに使う地域（配信サービス指定時に空の場合はErrRegionRequired）
+func (o DiscoverOptions) apply(params url.Values, region string) error {
+	set := func(key, value string) {
+		if value != "" {
+			params.Set(key, value)
+		}
+	}
+	setInt := func(key string, value int) {
+		if value > 0 {
+			params.Set(key, strconv.Itoa(value))
+		}
+	}
+
+	set("sort_by", o.SortBy)
+	setInt("primary_release_year", o.Year)
+	set("primary_release_date.gte", o.ReleaseDateFrom)
+	set("primary_release_date.lte", o.ReleaseDateTo)
+	if o.MinVoteAverage > 0 {
+		params.Set("vote_average.gte", strconv.FormatFloat(o.MinVoteAverage, 'f', -1, 64))
+	}
+	setInt("vote_count.gte", o.MinVoteCount)
+	setInt("with_runtime.gte", o.MinRuntime)
+	setInt("with_runtime.lte", o.MaxRuntime)
+	set("with_original_language", o.OriginalLanguage)
+	set("with_genres", o.Genres.String())
+	set("without_genres", joinInts(o.ExcludeGenres, ","))
+	set("with_keywords", o.Keywords.String())
+	set("with_companies", o.Companies.String())
+
+	if len(o.WatchProviders.IDs) > 0 {
+		// 配信サービスでの絞り込みには地域の指定が必要
+		if region == "" {
+			return ErrRegionRequired
+		}
+		params.Set("with_watch_providers", o.WatchProviders.String())
+		params.Set("watch_region", region)
+		set("with_watch_monetization_types", strings.Join(o.MonetizationTypes, "|"))
+	}
+	return nil
+}
+
+// joinInts は整数のリストを区切り文字で連結（TMDBでは"|"がOR、","がAND）
+func joinInts(values []int, sep string) string {
+	parts := make([]string, len(values))
+	for i, v := range values {
+		parts[i] = strconv.Itoa(v)
+	}
+	return strings.Join(parts, sep)
+}
@@ -0,0 +1,59 @@
+package services
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+// TestTMDBClient_GetMovies_DiscoverOptions - 絞り込み条件がTMDBのクエリパラメータに変換されることのテスト
+func TestTMDBClient_GetMovies_DiscoverOptions(t *testing.T) {
+	var gotQuery url.Values
+	client := newTestTMDBClient(t, func(w http.ResponseWriter, r *http.Request) {
+		gotQuery = r.URL.Query()
+		w.Write([]byte(`{"page":1,"results":[{"id":1,"title":"Movie","overview":"Overview"}]}`))
+	})
+
+	opts := DiscoverOptions{
+		SortBy:           "vote_average.desc",
+		Year:             1999,
+		MinVoteAverage:   7.5,
+		MinVoteCount:     100,
+		MinRuntime:       90,
+		MaxRuntime:       150,
+		OriginalLanguage: "ja",
+		Genres:           IDFilter{IDs: []int{28, 12}},
+		ExcludeGenres:    []int{27, 53},
+		Keywords:         IDFilter{IDs: []int{4379, 9882}, Any: true},
+		Companies:        IDFilter{IDs: []int{420}},
+	}
+	if _, err := client.GetMovies(context.Background(), 1, opts); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := map[string]string{
+		"sort_by":                "vote_average.desc",
+		"primary_release_year":   "1999",
+		"vote_average.gte":       "7.5",
+		"vote_count.gte":         "100",
+		"with_runtime.gte":       "90",
+		"with_runtime.lte":       "150",
+		"with_original_language": "ja",
+		"with_genres":            "28,12",
+		"without_genres":         "27,53",
+		"with_keywords":          "4379|9882",
+		"with_companies":         "420",
+	}
+	for key, value := range expected {
+		if got := gotQuery.Get(key); got != value {
+			t.Errorf("Expected %s=%s, got %q", key, value, got)
+		}
+	}
+	// 指定していない条件は送らない
+	for _, key := range []string{"primary_release_date.gte", "primary_release_date.lte", "with_watch_providers"} {
+		if gotQuery.Has(key) {
+			t.Errorf("Expected %s not to be sent, got %q", key, gotQuery.Get(key))
+		}
+	}
+}
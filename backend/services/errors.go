@@ -0,0 +1,99 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+)
+
+// ErrRequestCanceled はクライアントの切断などで呼び出し元がリクエストを中断したことを表す
+var ErrRequestCanceled = errors.New("クライアントがリクエストを中断しました")
+
+// UpstreamErrorKind はTMDB呼び出し失敗の種類
+type UpstreamErrorKind int
+
+const (
+	UpstreamBadGateway   UpstreamErrorKind = iota // TMDBが想定外のレスポンスを返した
+	UpstreamNotFound                              // 指定したリソースがTMDBに存在しない（404）
+	UpstreamUnauthorized                          // APIキーが無効・権限不足（401/403）
+	UpstreamRateLimited                           // TMDBのレート制限（429）
+	UpstreamUnavailable                           // TMDBに接続できない・停止中・サーキットブレーカー遮断中
+	UpstreamTimeout                               // TMDBの応答がタイムアウトした
+)
+
+// String はログ出力用の種類名を返す
+func (k UpstreamErrorKind) String() string {
+	switch k {
+	case UpstreamNotFound:
+		return "NOT_FOUND"
+	case UpstreamUnauthorized:
+		return "UNAUTHORIZED"
+	case UpstreamRateLimited:
+		return "RATE_LIMITED"
+	case UpstreamUnavailable:
+		return "UNAVAILABLE"
+	case UpstreamTimeout:
+		return "TIMEOUT"
+	default:
+		return "BAD_GATEWAY"
+	}
+}
+
+// UpstreamError はTMDB呼び出しの失敗を種類付きで表すエラー
+type UpstreamError struct {
+	Kind       UpstreamErrorKind
+	StatusCode int   // TMDBのHTTPステータス（レスポンスを受け取れなかった場合は0）
+	Err        error // 元のエラー（ステータスエラーの場合はnil）
+}
+
+// Error はerrorインターフェースを実装
+func (e *UpstreamError) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("TMDB APIリクエスト失敗: %v", e.Err)
+	}
+	return fmt.Sprintf("TMDB APIエラー: status=%d", e.StatusCode)
+}
+
+// Unwrap は元のエラーを返す
+func (e *UpstreamError) Unwrap() error {
+	return e.Err
+}
+
+// UpstreamErrorKindOf はerrがUpstreamErrorであればその種類を返す
+func UpstreamErrorKindOf(err error) (UpstreamErrorKind, bool) {
+	var upstreamErr *UpstreamError
+	if errors.As(err, &upstreamErr) {
+		return upstreamErr.Kind, true
+	}
+	return 0, false
+}
+
+// newStatusError はTMDBのHTTPステータスからUpstreamErrorを作成
+func newStatusError(statusCode int) *UpstreamError {
+	kind := UpstreamBadGateway
+	switch {
+	case statusCode == http.StatusNotFound:
+		kind = UpstreamNotFound
+	case statusCode == http.StatusUnauthorized || statusCode == http.StatusForbidden:
+		kind = UpstreamUnauthorized
+	case statusCode == http.StatusTooManyRequests:
+		kind = UpstreamRateLimited
+	case statusCode == http.StatusServiceUnavailable:
+		kind = UpstreamUnavailable
+	case statusCode == http.StatusGatewayTimeout:
+		kind = UpstreamTimeout
+	}
+	return &UpstreamError{Kind: kind, StatusCode: statusCode}
+}
+
+// newTransportError はTMDBへの通信エラーからUpstreamErrorを作成
+func newTransportError(err error) *UpstreamError {
+	kind := UpstreamUnavailable
+	var netErr net.Error
+	if errors.Is(err, context.DeadlineExceeded) || (errors.As(err, &netErr) && netErr.Timeout()) {
+		kind = UpstreamTimeout
+	}
+	return &UpstreamError{Kind: kind, Err: err}
+}
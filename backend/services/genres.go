@@ -0,0 +1,52 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"go-movie-explorer/models"
+)
+
+// ErrUnknownGenre はジャンル一覧に存在しないジャンルIDが指定された場合のエラー
+var ErrUnknownGenre = errors.New("存在しないジャンルIDです")
+
+// ErrGenreRequired はジャンル別一覧でジャンルIDが1件も指定されていない場合のエラー
+var ErrGenreRequired = errors.New("ジャンルIDが指定されていません")
+
+// resolveGenres はジャンルIDをジャンル一覧（キャッシュ対象）の名前付きのジャンルに変換
+// ジャンル一覧の取得に失敗した場合は名前なしで返し、ジャンル別一覧の取得は続ける
+func (c *TMDBClient) resolveGenres(ctx context.Context, ids, excludeIDs []int) ([]models.Genre, []models.Genre, error) {
+	names := map[int]string{}
+	list, err := c.GetGenres(ctx)
+	if err != nil {
+		log.Printf("ジャンル一覧の取得に失敗したためジャンル名なしで返します: %v", err)
+	} else {
+		for _, g := range list.Genres {
+			names[g.ID] = g.Name
+		}
+	}
+
+	resolve := func(ids []int) ([]models.Genre, error) {
+		genres := make([]models.Genre, 0, len(ids))
+		for _, id := range ids {
+			name, ok := names[id]
+			if !ok && list != nil {
+				return nil, fmt.Errorf("%w: %d", ErrUnknownGenre, id)
+			}
+			genres = append(genres, models.Genre{ID: id, Name: name})
+		}
+		return genres, nil
+	}
+
+	included, err := resolve(ids)
+	if err != nil {
+		return nil, nil, err
+	}
+	excluded, err := resolve(excludeIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+	return included, excluded, nil
+}
@@ -0,0 +1,58 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+// TestTMDBClient_GetMoviesByGenre - 複数ジャンルの指定とジャンル名の解決のテスト
+func TestTMDBClient_GetMoviesByGenre(t *testing.T) {
+	var gotQuery url.Values
+	genresStatus := http.StatusOK
+	client := newTestTMDBClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/genre/movie/list" {
+			w.WriteHeader(genresStatus)
+			w.Write([]byte(`{"genres":[{"id":28,"name":"アクション"},{"id":35,"name":"コメディ"},{"id":27,"name":"ホラー"}]}`))
+			return
+		}
+		gotQuery = r.URL.Query()
+		w.Write([]byte(`{"page":1,"total_pages":1,"total_results":1,"results":[{"id":1,"title":"Movie","overview":"Overview","genre_ids":[28,35]}]}`))
+	})
+
+	resp, err := client.GetMoviesByGenre(context.Background(), IDFilter{IDs: []int{28, 35}, Any: true}, []int{27}, 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if gotQuery.Get("with_genres") != "28|35" || gotQuery.Get("without_genres") != "27" {
+		t.Errorf("Unexpected discover query: %s", gotQuery.Encode())
+	}
+	if resp.GenreID != 28 || resp.Match != "any" || len(resp.GenreIDs) != 2 {
+		t.Errorf("Unexpected genre ids: %+v", resp)
+	}
+	if len(resp.Genres) != 2 || resp.Genres[1].Name != "コメディ" || len(resp.ExcludedGenres) != 1 || resp.ExcludedGenres[0].Name != "ホラー" {
+		t.Errorf("Expected resolved genre names, got %+v / %+v", resp.Genres, resp.ExcludedGenres)
+	}
+
+	// ジャンルIDが無い場合はTMDBを呼ばずにErrGenreRequired
+	if _, err := client.GetMoviesByGenre(context.Background(), IDFilter{}, nil, 1); !errors.Is(err, ErrGenreRequired) {
+		t.Errorf("Expected ErrGenreRequired, got %v", err)
+	}
+
+	// ジャンル一覧に無いIDはErrUnknownGenre
+	if _, err := client.GetMoviesByGenre(context.Background(), IDFilter{IDs: []int{28, 99999}}, nil, 1); !errors.Is(err, ErrUnknownGenre) {
+		t.Errorf("Expected ErrUnknownGenre, got %v", err)
+	}
+
+	// ジャンル一覧の取得に失敗してもジャンル名なしで一覧を返す
+	genresStatus = http.StatusInternalServerError
+	resp, err = client.GetMoviesByGenre(context.Background(), IDFilter{IDs: []int{28, 12}}, nil, 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if gotQuery.Get("with_genres") != "28,12" || resp.Match != "all" || len(resp.Genres) != 2 || resp.Genres[0].Name != "" {
+		t.Errorf("Expected unnamed genres without genre list, got %+v", resp)
+	}
+}
@@ -0,0 +1,43 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"go-movie-explorer/models"
+)
+
+// --- 映画のキーワード取得（/movie/{id}/keywords）---
+func (c *TMDBClient) GetMovieKeywords(ctx context.Context, id int) (*models.MovieKeywords, error) {
+	var tmdbResp models.TmdbMovieKeywordsResponse
+	if err := c.get(ctx, EndpointKeywords, fmt.Sprintf("/movie/%d/keywords", id), nil, &tmdbResp); err != nil {
+		return nil, err
+	}
+	return &models.MovieKeywords{
+		MovieID:  id,
+		Keywords: nonNil(tmdbResp.Keywords),
+	}, nil
+}
+
+// --- キーワード検索（/search/keyword）---
+func (c *TMDBClient) SearchKeywords(ctx context.Context, query string, page int) (*models.KeywordSearchResponse, error) {
+	if query == "" {
+		return nil, fmt.Errorf("検索クエリが指定されていません")
+	}
+
+	params := pageParams(page)
+	params.Set("query", query)
+
+	var tmdbResp models.KeywordSearchResponse
+	if err := c.get(ctx, EndpointSearchKeywords, "/search/keyword", params, &tmdbResp); err != nil {
+		return nil, err
+	}
+	tmdbResp.Results = nonNil(tmdbResp.Results)
+	return &tmdbResp, nil
+}
+
+// --- キーワード別映画取得（/discover/movie?with_keywords=）---
+// TMDBの/keyword/{id}/moviesは非推奨のため、映画一覧と同じdiscoverで取得する
+func (c *TMDBClient) GetKeywordMovies(ctx context.Context, id, page int) (*models.MoviesResponse, error) {
+	return c.GetMovies(ctx, page, DiscoverOptions{Keywords: IDFilter{IDs: []int{id}}})
+}
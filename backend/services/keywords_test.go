@@ -0,0 +1,59 @@
+package services
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+// TestTMDBClient_Keywords - キーワード取得・検索・キーワード別映画取得のテスト
+func TestTMDBClient_Keywords(t *testing.T) {
+	client := newTestTMDBClient(t, func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		switch r.URL.Path {
+		case "/movie/105/keywords":
+			if query.Has("language") {
+				t.Errorf("Expected no language for keywords, got %s", query.Get("language"))
+			}
+			w.Write([]byte(`{"id": 105, "keywords": [{"id": 4379, "name": "time travel"}]}`))
+		case "/search/keyword":
+			if query.Get("query") != "heist" || query.Has("language") {
+				t.Errorf("Unexpected search query: %s", query.Encode())
+			}
+			w.Write([]byte(`{"page": 1, "total_pages": 1, "total_results": 0, "results": null}`))
+		case "/discover/movie":
+			if query.Get("with_keywords") != "4379" || query.Get("page") != "2" {
+				t.Errorf("Unexpected discover query: %s", query.Encode())
+			}
+			w.Write([]byte(`{"page": 2, "total_pages": 3, "total_results": 41, "results": [{"id": 105, "title": "Back to the Future", "overview": "Marty"}]}`))
+		default:
+			t.Errorf("Unexpected path: %s", r.URL.Path)
+		}
+	})
+	ctx := WithLocale(context.Background(), Locale{Language: "ja-JP"})
+
+	keywords, err := client.GetMovieKeywords(ctx, 105)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if keywords.MovieID != 105 || len(keywords.Keywords) != 1 || keywords.Keywords[0].Name != "time travel" {
+		t.Errorf("Unexpected keywords: %+v", keywords)
+	}
+
+	// 該当なしでもresultsは空配列
+	found, err := client.SearchKeywords(ctx, "heist", 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if found.Results == nil || len(found.Results) != 0 {
+		t.Errorf("Expected empty results, got %+v", found.Results)
+	}
+
+	movies, err := client.GetKeywordMovies(ctx, 4379, 2)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if movies.Page != 2 || movies.TotalResults != 41 || len(movies.Results) != 1 {
+		t.Errorf("Unexpected movies: %+v", movies)
+	}
+}
@@ -0,0 +1,87 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"go-movie-explorer/models"
+)
+
+// TrendingWindow はトレンドの集計期間
+type TrendingWindow string
+
+const (
+	TrendingDay  TrendingWindow = "day" // 直近24時間（デフォルト）
+	TrendingWeek TrendingWindow = "week"
+)
+
+// ParseTrendingWindow はwindowパラメータを検証する（空の場合はTrendingDay）
+func ParseTrendingWindow(value string) (TrendingWindow, bool) {
+	switch w := TrendingWindow(value); w {
+	case "":
+		return TrendingDay, true
+	case TrendingDay, TrendingWeek:
+		return w, true
+	}
+	return "", false
+}
+
+// --- 上映中作品取得（/movie/now_playing）---
+func (c *TMDBClient) GetNowPlayingMovies(ctx context.Context, page int) (*models.DatedMoviesResponse, error) {
+	return c.getMovieList(ctx, EndpointNowPlaying, "/movie/now_playing", page)
+}
+
+// --- 公開予定作品取得（/movie/upcoming）---
+func (c *TMDBClient) GetUpcomingMovies(ctx context.Context, page int) (*models.DatedMoviesResponse, error) {
+	return c.getMovieList(ctx, EndpointUpcoming, "/movie/upcoming", page)
+}
+
+// --- 高評価作品取得（/movie/top_rated）---
+func (c *TMDBClient) GetTopRatedMovies(ctx context.Context, page int) (*models.DatedMoviesResponse, error) {
+	return c.getMovieList(ctx, EndpointTopRated, "/movie/top_rated", page)
+}
+
+// getMovieList はTMDBの映画リストを取得する
+// 上映中・公開予定はregionの劇場公開日で判定され、対象期間がdatesに含まれる
+func (c *TMDBClient) getMovieList(ctx context.Context, endpoint, path string, page int) (*models.DatedMoviesResponse, error) {
+	params := pageParams(page)
+
+	var tmdbResp models.DatedMoviesResponse
+	if err := c.get(ctx, endpoint, path, params, &tmdbResp); err != nil {
+		return nil, err
+	}
+	if tmdbResp.Results == nil {
+		tmdbResp.Results = []models.Movie{}
+	}
+	c.fillMovieOverviews(ctx, endpoint, path, params, tmdbResp.Results)
+	return &tmdbResp, nil
+}
+
+// --- トレンド作品取得（/trending/movie/{day|week}）---
+// 人気ランキング（/movie/popular）より短い期間の注目度で並ぶ。成人向け作品は除外する
+// TMDBのトレンドAPIには成人向け作品を除外するパラメータが無いためページ内で除外し、
+// total_results・total_pagesはTMDBの件数のまま返す（1ページの件数は20件未満になる場合がある）
+func (c *TMDBClient) GetTrendingMovies(ctx context.Context, window TrendingWindow, page int) (*models.MoviesResponse, error) {
+	path := fmt.Sprintf("/trending/movie/%s", window)
+	params := pageParams(page)
+
+	var tmdbResp models.TmdbMoviesResponse
+	if err := c.get(ctx, EndpointTrending, path, params, &tmdbResp); err != nil {
+		return nil, err
+	}
+
+	movies := []models.Movie{}
+	for _, m := range tmdbResp.Results {
+		if !m.Adult {
+			movies = append(movies, m.Movie)
+		}
+	}
+	c.fillMovieOverviews(ctx, EndpointTrending, path, params, movies)
+
+	return &models.MoviesResponse{
+		Page:         tmdbResp.Page,
+		TotalPages:   tmdbResp.TotalPages,
+		TotalResults: tmdbResp.TotalResults,
+		Results:      movies,
+	}, nil
+}
@@ -0,0 +1,85 @@
+package services
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"go-movie-explorer/models"
+)
+
+// TestTMDBClient_MovieLists - 上映中・公開予定・高評価作品の取得と地域の付与のテスト
+func TestTMDBClient_MovieLists(t *testing.T) {
+	var gotPath, gotQuery string
+	client := newTestTMDBClient(t, func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotQuery = r.URL.RawQuery
+		if r.URL.Path == "/movie/top_rated" {
+			w.Write([]byte(`{"page":2,"total_pages":10,"total_results":200,"results":[{"id":238,"title":"The Godfather","overview":"Overview"}]}`))
+			return
+		}
+		w.Write([]byte(`{"dates":{"maximum":"2025-07-16","minimum":"2025-06-04"},"page":2,"total_pages":3,"total_results":60,"results":[{"id":1,"title":"Movie","overview":"Overview"}]}`))
+	})
+
+	ctx := WithLocale(context.Background(), Locale{Language: "ja-JP", Region: "JP"})
+	tests := []struct {
+		path      string
+		fetch     func() (*models.DatedMoviesResponse, error)
+		withDates bool
+	}{
+		{path: "/movie/now_playing", fetch: func() (*models.DatedMoviesResponse, error) { return client.GetNowPlayingMovies(ctx, 2) }, withDates: true},
+		{path: "/movie/upcoming", fetch: func() (*models.DatedMoviesResponse, error) { return client.GetUpcomingMovies(ctx, 2) }, withDates: true},
+		{path: "/movie/top_rated", fetch: func() (*models.DatedMoviesResponse, error) { return client.GetTopRatedMovies(ctx, 2) }, withDates: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			resp, err := tt.fetch()
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if gotPath != tt.path || gotQuery != "language=ja-JP&page=2&region=JP" {
+				t.Errorf("Unexpected request: path=%s query=%s", gotPath, gotQuery)
+			}
+			if resp.Page != 2 || len(resp.Results) != 1 {
+				t.Errorf("Unexpected response: %+v", resp)
+			}
+			if (resp.Dates != nil) != tt.withDates {
+				t.Errorf("Expected dates present=%v, got %+v", tt.withDates, resp.Dates)
+			}
+			if tt.withDates && (resp.Dates.Minimum != "2025-06-04" || resp.Dates.Maximum != "2025-07-16") {
+				t.Errorf("Unexpected dates: %+v", resp.Dates)
+			}
+		})
+	}
+}
+
+// TestTMDBClient_GetTrendingMovies - トレンド作品の集計期間と成人向け作品の除外のテスト
+func TestTMDBClient_GetTrendingMovies(t *testing.T) {
+	var gotPath, gotQuery string
+	client := newTestTMDBClient(t, func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotQuery = r.URL.RawQuery
+		w.Write([]byte(`{"page":1,"total_pages":500,"total_results":10000,"results":[
+			{"id":1,"title":"Movie","overview":"Overview","media_type":"movie"},
+			{"id":2,"title":"Adult","overview":"Overview","media_type":"movie","adult":true}
+		]}`))
+	})
+
+	ctx := WithLocale(context.Background(), Locale{Language: "ja-JP", Region: "JP"})
+	resp, err := client.GetTrendingMovies(ctx, TrendingWeek, 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	// トレンドはregionを解釈しないため付与しない
+	if gotPath != "/trending/movie/week" || gotQuery != "language=ja-JP&page=1" {
+		t.Errorf("Unexpected request: path=%s query=%s", gotPath, gotQuery)
+	}
+	if len(resp.Results) != 1 || resp.Results[0].ID != 1 {
+		t.Errorf("Expected adult movie to be excluded, got %+v", resp)
+	}
+	// 件数はTMDBの値をそのまま返す
+	if resp.TotalResults != 10000 || resp.TotalPages != 500 {
+		t.Errorf("Expected upstream totals, got results=%d pages=%d", resp.TotalResults, resp.TotalPages)
+	}
+}
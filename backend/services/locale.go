@@ -0,0 +1,105 @@
+package services
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Locale はTMDBに渡す言語・地域の指定
+type Locale struct {
+	Language string // ISO 639-1（例: ja, ja-JP）
+	Region   string // ISO 3166-1（例: JP）
+}
+
+type localeKey struct{}
+
+// WithLocale はリクエスト毎の言語・地域を持つコンテキストを返す
+// 空のフィールドはTMDBConfigのLanguage / Regionで補われる
+func WithLocale(ctx context.Context, loc Locale) context.Context {
+	return context.WithValue(ctx, localeKey{}, loc)
+}
+
+// LocaleFromContext はコンテキストに設定された言語・地域を返す
+func LocaleFromContext(ctx context.Context) Locale {
+	loc, _ := ctx.Value(localeKey{}).(Locale)
+	return loc
+}
+
+// ParseLanguage はlanguageパラメータを検証し、TMDB形式（ja / ja-JP）に正規化する
+func ParseLanguage(value string) (string, bool) {
+	lang, region, hasRegion := strings.Cut(strings.ReplaceAll(value, "_", "-"), "-")
+	if !isAlpha(lang, 2) {
+		return "", false
+	}
+	lang = strings.ToLower(lang)
+	if !hasRegion {
+		return lang, true
+	}
+	if !isAlpha(region, 2) {
+		return "", false
+	}
+	return lang + "-" + strings.ToUpper(region), true
+}
+
+// ParseRegion はregionパラメータを検証し、大文字のISO 3166-1形式に正規化する
+func ParseRegion(value string) (string, bool) {
+	if !isAlpha(value, 2) {
+		return "", false
+	}
+	return strings.ToUpper(value), true
+}
+
+// RegionOf はja-JPのような言語タグから地域部分を返す（含まれない場合は空文字）
+func RegionOf(language string) string {
+	_, region, _ := strings.Cut(language, "-")
+	return region
+}
+
+// ParseAcceptLanguage はAccept-Languageヘッダーから最も優先度の高い有効な言語を返す
+// 有効な言語が無い場合は空文字を返す
+func ParseAcceptLanguage(header string) string {
+	type candidate struct {
+		lang string
+		q    float64
+	}
+	var candidates []candidate
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		lang, ok := ParseLanguage(strings.TrimSpace(tag))
+		if !ok {
+			continue
+		}
+		q := 1.0
+		if v, found := strings.CutPrefix(strings.TrimSpace(params), "q="); found {
+			parsed, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				continue
+			}
+			q = parsed
+		}
+		if q > 0 {
+			candidates = append(candidates, candidate{lang: lang, q: q})
+		}
+	}
+	if len(candidates) == 0 {
+		return ""
+	}
+	// 同じ優先度の場合はヘッダーでの記述順を優先
+	sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].q > candidates[j].q })
+	return candidates[0].lang
+}
+
+// isAlpha はsがn文字のASCII英字のみで構成されているかを判定
+func isAlpha(s string, n int) bool {
+	if len(s) != n {
+		return false
+	}
+	for _, c := range s {
+		if (c < 'a' || c > 'z') && (c < 'A' || c > 'Z') {
+			return false
+		}
+	}
+	return true
+}
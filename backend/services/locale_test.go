@@ -0,0 +1,143 @@
+package services
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// TestParseLanguage - languageパラメータの検証と正規化のテスト
+func TestParseLanguage(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+		valid    bool
+	}{
+		{input: "ja", expected: "ja", valid: true},
+		{input: "ja-JP", expected: "ja-JP", valid: true},
+		{input: "EN_us", expected: "en-US", valid: true},
+		{input: "japanese", valid: false},
+		{input: "ja-", valid: false},
+		{input: "zh-Hant-TW", valid: false},
+		{input: "", valid: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, ok := ParseLanguage(tt.input)
+			if ok != tt.valid || got != tt.expected {
+				t.Errorf("ParseLanguage(%q) = %q, %v; want %q, %v", tt.input, got, ok, tt.expected, tt.valid)
+			}
+		})
+	}
+}
+
+// TestParseAcceptLanguage - Accept-Languageから優先度の最も高い言語を選ぶことのテスト
+func TestParseAcceptLanguage(t *testing.T) {
+	tests := []struct {
+		header   string
+		expected string
+	}{
+		{header: "ja,en-US;q=0.9,en;q=0.8", expected: "ja"},
+		{header: "en;q=0.5, ja-JP;q=0.9", expected: "ja-JP"},
+		{header: "*, fr-FR;q=0.7", expected: "fr-FR"},
+		{header: "ja;q=0, en", expected: "en"},
+		{header: "zh-Hant-TW", expected: ""},
+		{header: "", expected: ""},
+	}
+
+	for _, tt := range tests {
+		if got := ParseAcceptLanguage(tt.header); got != tt.expected {
+			t.Errorf("ParseAcceptLanguage(%q) = %q, want %q", tt.header, got, tt.expected)
+		}
+	}
+}
+
+// TestTMDBClient_Locale - リクエストの言語・地域がTMDBに渡され、キャッシュキーが分かれることのテスト
+func TestTMDBClient_Locale(t *testing.T) {
+	var calls atomic.Int32
+	var gotQueries []string
+	client := newTestTMDBClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		gotQueries = append(gotQueries, r.URL.RawQuery)
+		w.Write([]byte(`{"page":1,"results":[{"id":1,"title":"映画","overview":"概要"}]}`))
+	})
+	client.Config().Language = "en-US"
+	client.Config().Region = "US"
+	client.Config().CacheTTLs = map[string]time.Duration{EndpointPopular: time.Minute, EndpointDetail: time.Minute}
+	client.cache = NewResponseCache(10, 0)
+
+	// コンテキストの指定がサーバー設定より優先される
+	jaCtx := WithLocale(context.Background(), Locale{Language: "ja-JP", Region: "JP"})
+	if _, err := client.GetPopularMovies(jaCtx, 1); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if gotQueries[0] != "language=ja-JP&page=1&region=JP" {
+		t.Errorf("Unexpected query: %s", gotQueries[0])
+	}
+
+	// 未指定の場合はサーバー設定を使い、別のキャッシュエントリになる
+	if _, err := client.GetPopularMovies(context.Background(), 1); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if calls.Load() != 2 || gotQueries[1] != "language=en-US&page=1&region=US" {
+		t.Errorf("Expected separate request with default locale, got %d calls: %v", calls.Load(), gotQueries)
+	}
+
+	// 同じ言語・地域はキャッシュから返す
+	if _, err := client.GetPopularMovies(jaCtx, 1); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if calls.Load() != 2 {
+		t.Errorf("Expected cached response, got %d calls", calls.Load())
+	}
+
+	// regionを解釈しないエンドポイントには付与しない
+	if _, err := client.GetMovieDetail(jaCtx, 1); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if gotQueries[2] != "append_to_response=release_dates&language=ja-JP" {
+		t.Errorf("Unexpected detail query: %s", gotQueries[2])
+	}
+}
+
+// TestTMDBClient_OverviewFallback - 翻訳された概要が空の場合に英語の概要で補完することのテスト
+func TestTMDBClient_OverviewFallback(t *testing.T) {
+	client := newTestTMDBClient(t, func(w http.ResponseWriter, r *http.Request) {
+		english := r.URL.Query().Get("language") == "en-US"
+		switch r.URL.Path {
+		case "/movie/1":
+			if english {
+				w.Write([]byte(`{"id":1,"title":"Movie","overview":"English overview"}`))
+			} else {
+				w.Write([]byte(`{"id":1,"title":"映画","overview":""}`))
+			}
+		default:
+			if english {
+				w.Write([]byte(`{"results":[{"id":1,"overview":"English 1"},{"id":2,"overview":"English 2"}]}`))
+			} else {
+				w.Write([]byte(`{"results":[{"id":1,"overview":""},{"id":2,"overview":"日本語の概要"}]}`))
+			}
+		}
+	})
+
+	ctx := WithLocale(context.Background(), Locale{Language: "ja"})
+
+	detail, err := client.GetMovieDetail(ctx, 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if detail.Title != "映画" || detail.Overview != "English overview" {
+		t.Errorf("Expected localized title with English overview, got %q / %q", detail.Title, detail.Overview)
+	}
+
+	list, err := client.GetPopularMovies(ctx, 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if list.Results[0].Overview != "English 1" || list.Results[1].Overview != "日本語の概要" {
+		t.Errorf("Expected only empty overviews to be filled, got %+v", list.Results)
+	}
+}
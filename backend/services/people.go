@@ -0,0 +1,198 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"slices"
+	"sort"
+	"strings"
+
+	"go-movie-explorer/models"
+)
+
+// PersonMoviesSort はフィルモグラフィーの並び順
+type PersonMoviesSort string
+
+const (
+	SortReleaseDateDesc PersonMoviesSort = "release_date.desc" // 新しい順（デフォルト）
+	SortReleaseDateAsc  PersonMoviesSort = "release_date.asc"
+	SortPopularityDesc  PersonMoviesSort = "popularity.desc"
+	SortPopularityAsc   PersonMoviesSort = "popularity.asc"
+)
+
+// ParsePersonMoviesSort はsortパラメータを検証する（空の場合はSortReleaseDateDesc）
+func ParsePersonMoviesSort(value string) (PersonMoviesSort, bool) {
+	switch s := PersonMoviesSort(value); s {
+	case "":
+		return SortReleaseDateDesc, true
+	case SortReleaseDateDesc, SortReleaseDateAsc, SortPopularityDesc, SortPopularityAsc:
+		return s, true
+	}
+	return "", false
+}
+
+// --- 人物詳細取得（/person/{id}）---
+func (c *TMDBClient) GetPerson(ctx context.Context, id int) (*models.Person, error) {
+	// プロフィール画像も1回のリクエストで取得する
+	path := fmt.Sprintf("/person/%d", id)
+	params := url.Values{"append_to_response": {"images"}}
+
+	var tmdbResp models.TmdbPersonResponse
+	if err := c.get(ctx, EndpointPerson, path, params, &tmdbResp); err != nil {
+		return nil, err
+	}
+
+	// 翻訳された経歴が無い場合は英語の経歴で補完
+	if fallbackCtx, ok := c.overviewFallbackContext(ctx); ok && tmdbResp.Biography == "" {
+		var english models.TmdbPersonResponse
+		if err := c.get(fallbackCtx, EndpointPerson, path, params, &english); err != nil {
+			log.Printf("英語の経歴の取得に失敗 (%s): %v", path, err)
+		} else {
+			tmdbResp.Biography = english.Biography
+		}
+	}
+
+	return &models.Person{
+		ID:                 tmdbResp.ID,
+		Name:               tmdbResp.Name,
+		AlsoKnownAs:        nonNil(tmdbResp.AlsoKnownAs),
+		Biography:          tmdbResp.Biography,
+		Birthday:           tmdbResp.Birthday,
+		Deathday:           tmdbResp.Deathday,
+		PlaceOfBirth:       tmdbResp.PlaceOfBirth,
+		Gender:             tmdbResp.Gender,
+		KnownForDepartment: tmdbResp.KnownForDepartment,
+		Homepage:           tmdbResp.Homepage,
+		IMDBID:             tmdbResp.IMDBID,
+		Popularity:         tmdbResp.Popularity,
+		ProfilePath:        tmdbResp.ProfilePath,
+		ProfileImages:      nonNil(tmdbResp.Images.Profiles),
+	}, nil
+}
+
+// --- 人物のフィルモグラフィー取得（/person/{id}/movie_credits）---
+func (c *TMDBClient) GetPersonMovies(ctx context.Context, id int, order PersonMoviesSort) (*models.PersonMoviesResponse, error) {
+	var tmdbResp models.TmdbPersonMovieCredits
+	if err := c.get(ctx, EndpointPersonCredits, fmt.Sprintf("/person/%d/movie_credits", id), nil, &tmdbResp); err != nil {
+		return nil, err
+	}
+
+	movies := mergePersonMovies(&tmdbResp)
+	sortPersonMovies(movies, order)
+	return &models.PersonMoviesResponse{
+		PersonID:     id,
+		Sort:         string(order),
+		TotalResults: len(movies),
+		Results:      movies,
+	}, nil
+}
+
+// mergePersonMovies は出演作品と参加作品を映画単位で1件にまとめる
+func mergePersonMovies(tmdbResp *models.TmdbPersonMovieCredits) []models.PersonMovie {
+	movies := []models.PersonMovie{}
+	index := map[int]int{}
+
+	entry := func(credit models.TmdbPersonMovieCredit) *models.PersonMovie {
+		if i, ok := index[credit.ID]; ok {
+			return &movies[i]
+		}
+		index[credit.ID] = len(movies)
+		movies = append(movies, models.PersonMovie{
+			ID:            credit.ID,
+			Title:         credit.Title,
+			OriginalTitle: credit.OriginalTitle,
+			ReleaseDate:   credit.ReleaseDate,
+			PosterPath:    credit.PosterPath,
+			VoteAverage:   credit.VoteAverage,
+			VoteCount:     credit.VoteCount,
+			Popularity:    credit.Popularity,
+		})
+		return &movies[len(movies)-1]
+	}
+
+	for _, credit := range tmdbResp.Cast {
+		movie := entry(credit)
+		// 1作品で複数の役を演じている場合はまとめて表示
+		if credit.Character != "" && !slices.Contains(strings.Split(movie.Character, " / "), credit.Character) {
+			if movie.Character != "" {
+				movie.Character += " / "
+			}
+			movie.Character += credit.Character
+		}
+	}
+	for _, credit := range tmdbResp.Crew {
+		movie := entry(credit)
+		if credit.Job != "" && !slices.Contains(movie.Jobs, credit.Job) {
+			movie.Jobs = append(movie.Jobs, credit.Job)
+		}
+	}
+	return movies
+}
+
+// sortPersonMovies はフィルモグラフィーを並び替える
+// 公開日が未定の作品は並び順に関わらず末尾に置く
+func sortPersonMovies(movies []models.PersonMovie, order PersonMoviesSort) {
+	sort.SliceStable(movies, func(i, j int) bool {
+		a, b := movies[i], movies[j]
+		switch order {
+		case SortPopularityDesc:
+			return a.Popularity > b.Popularity
+		case SortPopularityAsc:
+			return a.Popularity < b.Popularity
+		}
+		if (a.ReleaseDate == "") != (b.ReleaseDate == "") {
+			return b.ReleaseDate == ""
+		}
+		if order == SortReleaseDateAsc {
+			return a.ReleaseDate < b.ReleaseDate
+		}
+		return a.ReleaseDate > b.ReleaseDate
+	})
+}
+
+// --- 人物検索（/search/person）---
+func (c *TMDBClient) SearchPeople(ctx context.Context, query string, page int) (*models.PeopleSearchResponse, error) {
+	if c.config.APIKey == "" {
+		return nil, fmt.Errorf("TMDB_API_KEYが設定されていません")
+	}
+
+	if query == "" {
+		return nil, fmt.Errorf("検索クエリが指定されていません")
+	}
+
+	params := pageParams(page)
+	params.Set("query", query)
+
+	var tmdbResp models.TmdbPeopleSearchResponse
+	if err := c.get(ctx, EndpointSearchPeople, "/search/person", params, &tmdbResp); err != nil {
+		return nil, err
+	}
+
+	people := make([]models.PersonSummary, 0, len(tmdbResp.Results))
+	for _, p := range tmdbResp.Results {
+		// 代表作はTV番組を除いた映画のみ
+		knownFor := []models.Movie{}
+		for _, work := range p.KnownFor {
+			if work.MediaType == "movie" {
+				knownFor = append(knownFor, work.Movie)
+			}
+		}
+		people = append(people, models.PersonSummary{
+			ID:                 p.ID,
+			Name:               p.Name,
+			KnownForDepartment: p.KnownForDepartment,
+			ProfilePath:        p.ProfilePath,
+			Popularity:         p.Popularity,
+			KnownFor:           knownFor,
+		})
+	}
+
+	return &models.PeopleSearchResponse{
+		Page:         tmdbResp.Page,
+		TotalPages:   tmdbResp.TotalPages,
+		TotalResults: tmdbResp.TotalResults,
+		Results:      people,
+	}, nil
+}
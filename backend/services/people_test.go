@@ -0,0 +1,118 @@
+package services
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+// TestTMDBClient_GetPerson - 人物詳細とプロフィール画像の取得のテスト
+func TestTMDBClient_GetPerson(t *testing.T) {
+	var gotPath, gotQuery string
+	client := newTestTMDBClient(t, func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotQuery = r.URL.RawQuery
+		w.Write([]byte(`{
+			"id": 287, "name": "Brad Pitt", "biography": "An actor.", "birthday": "1963-12-18", "deathday": null,
+			"known_for_department": "Acting", "profile_path": "/pitt.jpg",
+			"images": {"profiles": [{"file_path": "/pitt.jpg", "width": 1000, "height": 1500, "aspect_ratio": 0.667}]}
+		}`))
+	})
+
+	person, err := client.GetPerson(context.Background(), 287)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if gotPath != "/person/287" || gotQuery != "append_to_response=images" {
+		t.Errorf("Unexpected request: %s?%s", gotPath, gotQuery)
+	}
+	if person.Name != "Brad Pitt" || person.Birthday != "1963-12-18" || person.Deathday != "" {
+		t.Errorf("Unexpected person: %+v", person)
+	}
+	if len(person.ProfileImages) != 1 || person.ProfileImages[0].Width != 1000 {
+		t.Errorf("Unexpected profile images: %+v", person.ProfileImages)
+	}
+	// also_known_asが無い場合もnullではなく空配列
+	if person.AlsoKnownAs == nil {
+		t.Error("Expected empty also_known_as, got nil")
+	}
+}
+
+// TestTMDBClient_GetPersonMovies - 出演・参加作品の統合と並び替えのテスト
+func TestTMDBClient_GetPersonMovies(t *testing.T) {
+	client := newTestTMDBClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{
+			"id": 1,
+			"cast": [
+				{"id": 100, "title": "Old", "release_date": "1999-10-15", "popularity": 50, "character": "Hero"},
+				{"id": 200, "title": "New", "release_date": "2020-01-01", "popularity": 10, "character": "Villain"},
+				{"id": 200, "title": "New", "release_date": "2020-01-01", "popularity": 10, "character": "Twin"},
+				{"id": 300, "title": "Upcoming", "release_date": "", "popularity": 90, "character": "Cameo"}
+			],
+			"crew": [
+				{"id": 200, "title": "New", "release_date": "2020-01-01", "popularity": 10, "job": "Director", "department": "Directing"},
+				{"id": 200, "title": "New", "release_date": "2020-01-01", "popularity": 10, "job": "Producer", "department": "Production"}
+			]
+		}`))
+	})
+
+	tests := []struct {
+		order    PersonMoviesSort
+		expected []int
+	}{
+		{order: SortReleaseDateDesc, expected: []int{200, 100, 300}},
+		{order: SortReleaseDateAsc, expected: []int{100, 200, 300}},
+		{order: SortPopularityDesc, expected: []int{300, 100, 200}},
+	}
+	for _, tt := range tests {
+		resp, err := client.GetPersonMovies(context.Background(), 1, tt.order)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if resp.TotalResults != 3 || len(resp.Results) != 3 {
+			t.Fatalf("Expected 3 merged movies, got %+v", resp.Results)
+		}
+		for i, id := range tt.expected {
+			if resp.Results[i].ID != id {
+				t.Errorf("%s: expected order %v, got %+v", tt.order, tt.expected, resp.Results)
+				break
+			}
+		}
+	}
+
+	resp, _ := client.GetPersonMovies(context.Background(), 1, SortReleaseDateDesc)
+	merged := resp.Results[0]
+	if merged.Character != "Villain / Twin" || len(merged.Jobs) != 2 || merged.Jobs[0] != "Director" {
+		t.Errorf("Expected cast and crew roles merged, got %+v", merged)
+	}
+}
+
+// TestTMDBClient_SearchPeople - 人物検索で代表作を映画のみに絞ることのテスト
+func TestTMDBClient_SearchPeople(t *testing.T) {
+	var gotQuery string
+	client := newTestTMDBClient(t, func(w http.ResponseWriter, r *http.Request) {
+		gotQuery = r.URL.Query().Get("query")
+		w.Write([]byte(`{"page": 1, "total_pages": 1, "total_results": 1, "results": [{
+			"id": 6384, "name": "Keanu Reeves", "known_for_department": "Acting",
+			"known_for": [
+				{"id": 603, "title": "The Matrix", "media_type": "movie"},
+				{"id": 1399, "name": "Some Show", "media_type": "tv"}
+			]
+		}]}`))
+	})
+
+	if _, err := client.SearchPeople(context.Background(), "", 1); err == nil {
+		t.Error("Expected error for empty query")
+	}
+
+	resp, err := client.SearchPeople(context.Background(), "keanu", 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if gotQuery != "keanu" {
+		t.Errorf("Expected query keanu, got %s", gotQuery)
+	}
+	if len(resp.Results) != 1 || len(resp.Results[0].KnownFor) != 1 || resp.Results[0].KnownFor[0].Title != "The Matrix" {
+		t.Errorf("Unexpected results: %+v", resp.Results)
+	}
+}
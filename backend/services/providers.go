@@ -0,0 +1,44 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"go-movie-explorer/models"
+)
+
+// ErrRegionRequired は地域が必要なリクエストで地域を決定できなかった場合のエラー
+var ErrRegionRequired = errors.New("regionが指定されていません")
+
+// --- 配信サービス取得（/movie/{id}/watch/providers）---
+// TMDBは全地域分をまとめて返すため、キャッシュは映画単位で持ち地域はここで選ぶ
+func (c *TMDBClient) GetMovieWatchProviders(ctx context.Context, id int) (*models.MovieWatchProviders, error) {
+	region := c.locale(ctx).Region
+	if region == "" {
+		return nil, ErrRegionRequired
+	}
+
+	var tmdbResp models.TmdbWatchProvidersResponse
+	if err := c.get(ctx, EndpointWatchProviders, fmt.Sprintf("/movie/%d/watch/providers", id), nil, &tmdbResp); err != nil {
+		return nil, err
+	}
+
+	regional := tmdbResp.Results[region]
+	return &models.MovieWatchProviders{
+		MovieID:  id,
+		Region:   region,
+		Link:     regional.Link,
+		Flatrate: sortWatchProviders(regional.Flatrate),
+		Rent:     sortWatchProviders(regional.Rent),
+		Buy:      sortWatchProviders(regional.Buy),
+	}, nil
+}
+
+// sortWatchProviders は配信サービスをdisplay_priority順に並べたコピーを返す
+func sortWatchProviders(providers []models.WatchProvider) []models.WatchProvider {
+	sorted := append([]models.WatchProvider{}, providers...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].DisplayPriority < sorted[j].DisplayPriority })
+	return sorted
+}
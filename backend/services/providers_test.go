@@ -0,0 +1,95 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+// TestTMDBClient_GetMovieWatchProviders - 地域の選択と表示優先度順の並び替えのテスト
+func TestTMDBClient_GetMovieWatchProviders(t *testing.T) {
+	var gotPath, gotQuery string
+	client := newTestTMDBClient(t, func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotQuery = r.URL.RawQuery
+		w.Write([]byte(`{"id": 550, "results": {
+			"JP": {
+				"link": "https://www.themoviedb.org/movie/550/watch?locale=JP",
+				"flatrate": [
+					{"provider_id": 9, "provider_name": "Amazon Prime Video", "logo_path": "/prime.jpg", "display_priority": 5},
+					{"provider_id": 8, "provider_name": "Netflix", "logo_path": "/netflix.jpg", "display_priority": 1}
+				],
+				"rent": [{"provider_id": 2, "provider_name": "Apple TV", "logo_path": "/apple.jpg", "display_priority": 3}]
+			},
+			"US": {"flatrate": [{"provider_id": 15, "provider_name": "Hulu", "display_priority": 2}]}
+		}}`))
+	})
+
+	ctx := WithLocale(context.Background(), Locale{Language: "ja-JP", Region: "JP"})
+	providers, err := client.GetMovieWatchProviders(ctx, 550)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	// 全地域分が返るため言語・地域はリクエストに含めない
+	if gotPath != "/movie/550/watch/providers" || gotQuery != "" {
+		t.Errorf("Unexpected request: path=%s query=%s", gotPath, gotQuery)
+	}
+	if providers.Region != "JP" || providers.Link == "" {
+		t.Errorf("Unexpected region or link: %+v", providers)
+	}
+	if len(providers.Flatrate) != 2 || providers.Flatrate[0].ProviderName != "Netflix" {
+		t.Errorf("Expected flatrate providers sorted by display priority, got %+v", providers.Flatrate)
+	}
+	if len(providers.Rent) != 1 || providers.Buy == nil || len(providers.Buy) != 0 {
+		t.Errorf("Expected one rent provider and empty buy list, got rent=%+v buy=%+v", providers.Rent, providers.Buy)
+	}
+
+	// 配信情報が無い地域は空のリストを返す
+	providers, err = client.GetMovieWatchProviders(WithLocale(context.Background(), Locale{Region: "FR"}), 550)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(providers.Flatrate) != 0 || providers.Flatrate == nil {
+		t.Errorf("Expected empty flatrate list for FR, got %+v", providers.Flatrate)
+	}
+}
+
+// TestTMDBClient_GetMovieWatchProviders_RegionRequired - 地域を決定できない場合のエラーのテスト
+func TestTMDBClient_GetMovieWatchProviders_RegionRequired(t *testing.T) {
+	client := newTestTMDBClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Unexpected upstream request: %s", r.URL)
+	})
+
+	if _, err := client.GetMovieWatchProviders(context.Background(), 550); !errors.Is(err, ErrRegionRequired) {
+		t.Errorf("Expected ErrRegionRequired, got %v", err)
+	}
+	if _, err := client.GetMovies(context.Background(), 1, DiscoverOptions{WatchProviders: IDFilter{IDs: []int{8}}}); !errors.Is(err, ErrRegionRequired) {
+		t.Errorf("Expected ErrRegionRequired from GetMovies, got %v", err)
+	}
+}
+
+// TestTMDBClient_GetMovies_WatchProviders - 配信サービスでの絞り込みパラメータのテスト
+func TestTMDBClient_GetMovies_WatchProviders(t *testing.T) {
+	var gotQuery map[string][]string
+	client := newTestTMDBClient(t, func(w http.ResponseWriter, r *http.Request) {
+		gotQuery = r.URL.Query()
+		w.Write([]byte(`{"page":1,"results":[{"id":1,"title":"Movie","overview":"Overview"}]}`))
+	})
+
+	ctx := WithLocale(context.Background(), Locale{Language: "en-US", Region: "JP"})
+	opts := DiscoverOptions{WatchProviders: IDFilter{IDs: []int{8, 119}, Any: true}, MonetizationTypes: []string{"flatrate", "free"}}
+	if _, err := client.GetMovies(ctx, 1, opts); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := map[string]string{
+		"with_watch_providers":          "8|119",
+		"watch_region":                  "JP",
+		"with_watch_monetization_types": "flatrate|free",
+	}
+	for key, value := range expected {
+		if got := gotQuery[key]; len(got) != 1 || got[0] != value {
+			t.Errorf("Expected %s=%s, got %v", key, value, got)
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"go-movie-explorer/models"
+)
+
+// MovieListFilter は関連作品一覧の絞り込み条件
+type MovieListFilter struct {
+	ExcludeIDs   map[int]bool // 除外する映画ID（視聴済みの作品など）
+	IncludeAdult bool         // 成人向け作品を含めるか
+}
+
+// --- おすすめ作品取得（/movie/{id}/recommendations）---
+func (c *TMDBClient) GetMovieRecommendations(ctx context.Context, id, page int, filter MovieListFilter) (*models.MoviesResponse, error) {
+	return c.getRelatedMovies(ctx, EndpointRecommendations, fmt.Sprintf("/movie/%d/recommendations", id), page, filter)
+}
+
+// --- 類似作品取得（/movie/{id}/similar）---
+func (c *TMDBClient) GetSimilarMovies(ctx context.Context, id, page int, filter MovieListFilter) (*models.MoviesResponse, error) {
+	return c.getRelatedMovies(ctx, EndpointSimilar, fmt.Sprintf("/movie/%d/similar", id), page, filter)
+}
+
+// getRelatedMovies は関連作品一覧を取得し、絞り込み条件に合わない作品を除外する
+// 除外はページ内で行うため、total_resultsはTMDBの件数のまま返す
+func (c *TMDBClient) getRelatedMovies(ctx context.Context, endpoint, path string, page int, filter MovieListFilter) (*models.MoviesResponse, error) {
+	params := pageParams(page)
+
+	var tmdbResp models.TmdbMoviesResponse
+	if err := c.get(ctx, endpoint, path, params, &tmdbResp); err != nil {
+		return nil, err
+	}
+
+	movies := []models.Movie{}
+	for _, m := range tmdbResp.Results {
+		if (m.Adult && !filter.IncludeAdult) || filter.ExcludeIDs[m.ID] {
+			continue
+		}
+		movies = append(movies, m.Movie)
+	}
+	c.fillMovieOverviews(ctx, endpoint, path, params, movies)
+
+	return &models.MoviesResponse{
+		Page:         tmdbResp.Page,
+		TotalPages:   tmdbResp.TotalPages,
+		TotalResults: tmdbResp.TotalResults,
+		Results:      movies,
+	}, nil
+}
@@ -0,0 +1,47 @@
+package services
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+// TestTMDBClient_RelatedMovies - おすすめ・類似作品の取得と絞り込みのテスト
+func TestTMDBClient_RelatedMovies(t *testing.T) {
+	var gotPaths []string
+	client := newTestTMDBClient(t, func(w http.ResponseWriter, r *http.Request) {
+		gotPaths = append(gotPaths, r.URL.Path+"?"+r.URL.RawQuery)
+		w.Write([]byte(`{"page": 2, "total_pages": 5, "total_results": 100, "results": [
+			{"id": 1, "title": "Seen", "adult": false},
+			{"id": 2, "title": "Adult", "adult": true},
+			{"id": 3, "title": "Fresh", "adult": false}
+		]}`))
+	})
+
+	filter := MovieListFilter{ExcludeIDs: map[int]bool{1: true}}
+	resp, err := client.GetMovieRecommendations(context.Background(), 550, 2, filter)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(resp.Results) != 1 || resp.Results[0].ID != 3 {
+		t.Errorf("Expected seen and adult movies excluded, got %+v", resp.Results)
+	}
+	if resp.Page != 2 || resp.TotalPages != 5 {
+		t.Errorf("Unexpected pagination: %+v", resp)
+	}
+
+	resp, err = client.GetSimilarMovies(context.Background(), 550, 1, MovieListFilter{IncludeAdult: true})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(resp.Results) != 3 {
+		t.Errorf("Expected adult movies included, got %+v", resp.Results)
+	}
+
+	expected := []string{"/movie/550/recommendations?page=2", "/movie/550/similar?page=1"}
+	for i, path := range expected {
+		if gotPaths[i] != path {
+			t.Errorf("Expected request %s, got %s", path, gotPaths[i])
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"go-movie-explorer/models"
+)
+
+// TMDBのリリース種別（release_dates.type）
+const (
+	releaseTypePremiere          = 1
+	releaseTypeTheatricalLimited = 2
+	releaseTypeTheatrical        = 3
+	releaseTypeDigital           = 4
+	releaseTypePhysical          = 5
+	releaseTypeTV                = 6
+)
+
+var releaseTypeNames = map[int]string{
+	releaseTypePremiere:          "premiere",
+	releaseTypeTheatricalLimited: "theatrical_limited",
+	releaseTypeTheatrical:        "theatrical",
+	releaseTypeDigital:           "digital",
+	releaseTypePhysical:          "physical",
+	releaseTypeTV:                "tv",
+}
+
+// certificationPriority は国の代表的な年齢制限を選ぶ際のリリース種別の優先順
+// 劇場公開時のレーティングを優先し、無い場合は配信・パッケージのものを使う
+var certificationPriority = []int{
+	releaseTypeTheatrical,
+	releaseTypeTheatricalLimited,
+	releaseTypePremiere,
+	releaseTypeDigital,
+	releaseTypePhysical,
+	releaseTypeTV,
+}
+
+// --- 公開日・年齢制限取得（/movie/{id}/release_dates）---
+// TMDBは全地域分をまとめて返すため、キャッシュは映画単位で持ち地域はここで選ぶ
+func (c *TMDBClient) GetMovieReleases(ctx context.Context, id int) (*models.MovieReleases, error) {
+	var tmdbResp models.TmdbReleaseDatesResponse
+	if err := c.get(ctx, EndpointReleaseDates, fmt.Sprintf("/movie/%d/release_dates", id), nil, &tmdbResp); err != nil {
+		return nil, err
+	}
+
+	region := c.locale(ctx).Region
+	releases := &models.MovieReleases{
+		MovieID:       id,
+		Region:        region,
+		Certification: certification(tmdbResp.Results, region),
+		Countries:     make([]models.CountryReleases, 0, len(tmdbResp.Results)),
+	}
+	for _, country := range tmdbResp.Results {
+		releases.Countries = append(releases.Countries, newCountryReleases(country))
+	}
+	sort.Slice(releases.Countries, func(i, j int) bool {
+		return releases.Countries[i].Country < releases.Countries[j].Country
+	})
+	return releases, nil
+}
+
+// newCountryReleases はTMDBの国毎のリリースを公開日順のCountryReleasesに変換
+func newCountryReleases(country models.TmdbCountryReleaseDates) models.CountryReleases {
+	releases := make([]models.Release, 0, len(country.ReleaseDates))
+	for _, r := range country.ReleaseDates {
+		descriptors := r.Descriptors
+		if descriptors == nil {
+			descriptors = []string{}
+		}
+		releases = append(releases, models.Release{
+			Type:          releaseTypeNames[r.Type],
+			ReleaseDate:   releaseDay(r.ReleaseDate),
+			Certification: strings.TrimSpace(r.Certification),
+			Descriptors:   descriptors,
+			Language:      r.Language,
+			Note:          r.Note,
+		})
+	}
+	sort.SliceStable(releases, func(i, j int) bool { return releases[i].ReleaseDate < releases[j].ReleaseDate })
+
+	return models.CountryReleases{
+		Country:       country.Country,
+		Certification: countryCertification(country.ReleaseDates),
+		Releases:      releases,
+	}
+}
+
+// certification は指定地域の代表的な年齢制限を返す（地域が無い・レーティングが無い場合は空文字）
+func certification(countries []models.TmdbCountryReleaseDates, region string) string {
+	if region == "" {
+		return ""
+	}
+	for _, country := range countries {
+		if strings.EqualFold(country.Country, region) {
+			return countryCertification(country.ReleaseDates)
+		}
+	}
+	return ""
+}
+
+// countryCertification はリリース種別の優先順で最初に見つかった年齢制限を返す
+func countryCertification(dates []models.TmdbReleaseDate) string {
+	for _, releaseType := range certificationPriority {
+		for _, r := range dates {
+			if c := strings.TrimSpace(r.Certification); r.Type == releaseType && c != "" {
+				return c
+			}
+		}
+	}
+	return ""
+}
+
+// releaseDay はTMDBの日時（1999-10-15T00:00:00.000Z）を日付部分のみにする
+func releaseDay(value string) string {
+	if day, _, ok := strings.Cut(value, "T"); ok {
+		return day
+	}
+	return value
+}
@@ -0,0 +1,94 @@
+package services
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+// releaseDatesFixture はTMDBの/movie/{id}/release_datesのレスポンス例
+const releaseDatesFixture = `{"id": 550, "results": [
+	{"iso_3166_1": "US", "release_dates": [
+		{"certification": "R", "iso_639_1": "", "note": "", "release_date": "1999-10-15T00:00:00.000Z", "type": 3},
+		{"certification": "", "iso_639_1": "", "note": "Venice Film Festival", "release_date": "1999-09-10T00:00:00.000Z", "type": 1}
+	]},
+	{"iso_3166_1": "JP", "release_dates": [
+		{"certification": "", "iso_639_1": "", "note": "Blu-ray", "release_date": "2009-12-18T00:00:00.000Z", "type": 5},
+		{"certification": "R15+ ", "descriptors": ["暴力"], "iso_639_1": "ja", "note": "", "release_date": "1999-12-11T00:00:00.000Z", "type": 3}
+	]}
+]}`
+
+// TestTMDBClient_GetMovieReleases - 国毎の公開日の変換とリクエスト地域の年齢制限のテスト
+func TestTMDBClient_GetMovieReleases(t *testing.T) {
+	var gotPath, gotQuery string
+	client := newTestTMDBClient(t, func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotQuery = r.URL.RawQuery
+		w.Write([]byte(releaseDatesFixture))
+	})
+
+	ctx := WithLocale(context.Background(), Locale{Language: "ja-JP", Region: "JP"})
+	releases, err := client.GetMovieReleases(ctx, 550)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	// 全地域分が返るため言語・地域はリクエストに含めない
+	if gotPath != "/movie/550/release_dates" || gotQuery != "" {
+		t.Errorf("Unexpected request: path=%s query=%s", gotPath, gotQuery)
+	}
+	if releases.Region != "JP" || releases.Certification != "R15+" {
+		t.Errorf("Expected JP certification R15+, got %q / %q", releases.Region, releases.Certification)
+	}
+	if len(releases.Countries) != 2 || releases.Countries[0].Country != "JP" || releases.Countries[1].Certification != "R" {
+		t.Fatalf("Expected countries sorted by code with certifications, got %+v", releases.Countries)
+	}
+
+	jp := releases.Countries[0].Releases
+	if len(jp) != 2 || jp[0].Type != "theatrical" || jp[0].ReleaseDate != "1999-12-11" || jp[1].Type != "physical" {
+		t.Errorf("Expected JP releases sorted by date with type names, got %+v", jp)
+	}
+	if jp[1].Descriptors == nil {
+		t.Errorf("Expected empty descriptors instead of null")
+	}
+
+	// 地域が無い場合は年齢制限を空にして全ての国を返す
+	releases, err = client.GetMovieReleases(context.Background(), 550)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if releases.Certification != "" || len(releases.Countries) != 2 {
+		t.Errorf("Unexpected releases without region: %+v", releases)
+	}
+}
+
+// TestTMDBClient_GetMovieDetail_Certification - 映画詳細にリクエスト地域の年齢制限を含めることのテスト
+func TestTMDBClient_GetMovieDetail_Certification(t *testing.T) {
+	var gotQuery string
+	client := newTestTMDBClient(t, func(w http.ResponseWriter, r *http.Request) {
+		gotQuery = r.URL.Query().Get("append_to_response")
+		w.Write([]byte(`{"id": 550, "title": "Fight Club", "overview": "Overview", "release_dates": ` + releaseDatesFixture + `}`))
+	})
+
+	tests := []struct {
+		region   string
+		expected string
+	}{
+		{region: "JP", expected: "R15+"},
+		{region: "US", expected: "R"},
+		{region: "FR", expected: ""},
+		{region: "", expected: ""},
+	}
+	for _, tt := range tests {
+		ctx := WithLocale(context.Background(), Locale{Language: "en-US", Region: tt.region})
+		detail, err := client.GetMovieDetail(ctx, 550)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if gotQuery != "release_dates" {
+			t.Errorf("Expected append_to_response=release_dates, got %q", gotQuery)
+		}
+		if detail.Certification != tt.expected {
+			t.Errorf("region=%q: expected certification %q, got %q", tt.region, tt.expected, detail.Certification)
+		}
+	}
+}
@@ -0,0 +1,174 @@
+package services
+
+import (
+	"context"
+	"io"
+	"log"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryConfig はTMDBリクエストのリトライ設定
+type RetryConfig struct {
+	MaxAttempts   int           // 初回を含む最大試行回数（1以下の場合はリトライしない）
+	BaseDelay     time.Duration // バックオフの基準待機時間（試行毎に2倍）
+	MaxDelay      time.Duration // バックオフの最大待機時間
+	MaxRetryAfter time.Duration // Retry-Afterの上限（これより長い待機を要求された場合はリトライしない）
+}
+
+// DefaultRetryConfig はデフォルトのリトライ設定を返す
+func DefaultRetryConfig() *RetryConfig {
+	return &RetryConfig{
+		MaxAttempts:   3,
+		BaseDelay:     200 * time.Millisecond,
+		MaxDelay:      2 * time.Second,
+		MaxRetryAfter: 5 * time.Second,
+	}
+}
+
+// RetryTransport は冪等なリクエストをネットワークエラー・5xx・429の場合にリトライするRoundTripper
+// 待機時間はジッター付きの指数バックオフで、429/503のRetry-Afterヘッダーがあればそれに従う
+type RetryTransport struct {
+	base   http.RoundTripper
+	config *RetryConfig
+
+	// テストで差し替えるため
+	sleep  func(ctx context.Context, d time.Duration) error
+	jitter func() float64
+}
+
+// NewRetryTransport はbaseをリトライ処理で包んだRoundTripperを作成
+// configがnilの場合はDefaultRetryConfigを使用
+func NewRetryTransport(base http.RoundTripper, config *RetryConfig) *RetryTransport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	if config == nil {
+		config = DefaultRetryConfig()
+	}
+	return &RetryTransport{
+		base:   base,
+		config: config,
+		sleep:  sleepContext,
+		jitter: rand.Float64,
+	}
+}
+
+// RoundTrip はhttp.RoundTripperの実装
+func (t *RetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !isIdempotent(req) {
+		return t.base.RoundTrip(req)
+	}
+
+	for attempt := 1; ; attempt++ {
+		// 2回目以降はボディを再生成（GETでは通常不要）
+		if attempt > 1 && req.Body != nil && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+		}
+
+		resp, err := t.base.RoundTrip(req)
+		if attempt >= t.config.MaxAttempts || !shouldRetry(req, resp, err) {
+			if attempt > 1 {
+				log.Printf("TMDBリクエスト完了 (試行回数: %d) %s", attempt, describeResult(resp, err))
+			}
+			return resp, err
+		}
+
+		delay := t.backoff(attempt)
+		if resp != nil {
+			if retryAfter, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+				if retryAfter > t.config.MaxRetryAfter {
+					// 待機が長すぎる場合はリトライせずそのまま返す
+					log.Printf("TMDBのRetry-Afterが上限を超えるためリトライしません (Retry-After: %v)", retryAfter)
+					return resp, nil
+				}
+				delay = retryAfter
+			}
+			// 次の試行の前にコネクションを再利用できるようボディを読み捨てる
+			io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+			resp.Body.Close()
+		}
+
+		log.Printf("TMDBリクエストをリトライします (%d/%d, %v後) %s %s: %s",
+			attempt+1, t.config.MaxAttempts, delay, req.Method, req.URL.Path, describeResult(resp, err))
+
+		if err := t.sleep(req.Context(), delay); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// backoff はattempt回目の失敗後の待機時間を返す（フルジッター）
+func (t *RetryTransport) backoff(attempt int) time.Duration {
+	delay := t.config.BaseDelay << (attempt - 1)
+	if delay <= 0 || delay > t.config.MaxDelay {
+		delay = t.config.MaxDelay
+	}
+	return time.Duration(t.jitter() * float64(delay))
+}
+
+// isIdempotent はリトライ可能なメソッドかを判定
+func isIdempotent(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+	}
+	return false
+}
+
+// shouldRetry はレスポンスまたはエラーがリトライ対象かを判定
+func shouldRetry(req *http.Request, resp *http.Response, err error) bool {
+	if err != nil {
+		// 呼び出し元のキャンセル・タイムアウトはリトライしない
+		return req.Context().Err() == nil
+	}
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests,
+		http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// parseRetryAfter はRetry-Afterヘッダー（秒数またはHTTP日付）を待機時間に変換
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return max(time.Until(date), 0), true
+	}
+	return 0, false
+}
+
+// describeResult はログ出力用に結果を文字列化
+func describeResult(resp *http.Response, err error) string {
+	if err != nil {
+		return "error=" + err.Error()
+	}
+	return "status=" + strconv.Itoa(resp.StatusCode)
+}
+
+// sleepContext はctxがキャンセルされるまでd待機する
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,219 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newTestRetryTransport は待機を記録するだけのRetryTransportを作成するヘルパー関数
+func newTestRetryTransport(config *RetryConfig) (*RetryTransport, *[]time.Duration) {
+	var delays []time.Duration
+	transport := NewRetryTransport(nil, config)
+	transport.sleep = func(ctx context.Context, d time.Duration) error {
+		delays = append(delays, d)
+		return ctx.Err()
+	}
+	transport.jitter = func() float64 { return 1 }
+	return transport, &delays
+}
+
+// TestRetryTransport_RetriesServerErrors - 5xxの場合に指数バックオフでリトライすることのテスト
+func TestRetryTransport_RetriesServerErrors(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) < 3 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	transport, delays := newTestRetryTransport(&RetryConfig{MaxAttempts: 3, BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second, MaxRetryAfter: time.Second})
+	client := &http.Client{Transport: transport}
+
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("Expected status 200 after retries, got %d", resp.StatusCode)
+	}
+	if requests.Load() != 3 {
+		t.Errorf("Expected 3 attempts, got %d", requests.Load())
+	}
+	expected := []time.Duration{100 * time.Millisecond, 200 * time.Millisecond}
+	if len(*delays) != len(expected) || (*delays)[0] != expected[0] || (*delays)[1] != expected[1] {
+		t.Errorf("Expected delays %v, got %v", expected, *delays)
+	}
+}
+
+// TestRetryTransport_MaxAttempts - 最大試行回数で打ち切ることのテスト
+func TestRetryTransport_MaxAttempts(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	transport, _ := newTestRetryTransport(DefaultRetryConfig())
+	client := &http.Client{Transport: transport}
+
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("Expected last status 503, got %d", resp.StatusCode)
+	}
+	if requests.Load() != 3 {
+		t.Errorf("Expected 3 attempts, got %d", requests.Load())
+	}
+}
+
+// TestRetryTransport_RetryAfter - 429のRetry-Afterに従うことのテスト
+func TestRetryTransport_RetryAfter(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) == 1 {
+			w.Header().Set("Retry-After", "2")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	transport, delays := newTestRetryTransport(&RetryConfig{MaxAttempts: 3, BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second, MaxRetryAfter: 5 * time.Second})
+	client := &http.Client{Transport: transport}
+
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("Expected status 200, got %d", resp.StatusCode)
+	}
+	if len(*delays) != 1 || (*delays)[0] != 2*time.Second {
+		t.Errorf("Expected single delay of 2s from Retry-After, got %v", *delays)
+	}
+}
+
+// TestRetryTransport_RetryAfterTooLong - Retry-Afterが上限を超える場合はリトライしないことのテスト
+func TestRetryTransport_RetryAfterTooLong(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	transport, _ := newTestRetryTransport(DefaultRetryConfig())
+	client := &http.Client{Transport: transport}
+
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusTooManyRequests {
+		t.Errorf("Expected status 429, got %d", resp.StatusCode)
+	}
+	if requests.Load() != 1 {
+		t.Errorf("Expected no retry, got %d attempts", requests.Load())
+	}
+}
+
+// TestRetryTransport_NoRetry - リトライ対象外のケースのテスト
+func TestRetryTransport_NoRetry(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	transport, _ := newTestRetryTransport(DefaultRetryConfig())
+	client := &http.Client{Transport: transport}
+
+	// 非冪等なPOSTはリトライしない
+	resp, err := client.Post(server.URL, "application/json", strings.NewReader("{}"))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	resp.Body.Close()
+	if requests.Load() != 1 {
+		t.Errorf("Expected POST not to be retried, got %d attempts", requests.Load())
+	}
+
+	// 4xx（429以外）はリトライしない
+	requests.Store(0)
+	resp, err = client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	resp.Body.Close()
+	if requests.Load() != 1 {
+		t.Errorf("Expected 404 not to be retried, got %d attempts", requests.Load())
+	}
+}
+
+// TestRetryTransport_ContextCanceled - 待機中にキャンセルされた場合は中断することのテスト
+func TestRetryTransport_ContextCanceled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	transport := NewRetryTransport(nil, &RetryConfig{MaxAttempts: 5, BaseDelay: time.Hour, MaxDelay: time.Hour, MaxRetryAfter: time.Second})
+	client := &http.Client{Transport: transport}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	req, _ := http.NewRequestWithContext(ctx, "GET", server.URL, nil)
+
+	start := time.Now()
+	_, err := client.Do(req)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected context.DeadlineExceeded, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Expected retry wait to be interrupted, took %v", elapsed)
+	}
+}
+
+// TestParseRetryAfter - Retry-Afterヘッダーの解析テスト
+func TestParseRetryAfter(t *testing.T) {
+	if d, ok := parseRetryAfter("3"); !ok || d != 3*time.Second {
+		t.Errorf("Expected 3s, got %v (ok=%v)", d, ok)
+	}
+	future := time.Now().Add(10 * time.Second).UTC().Format(http.TimeFormat)
+	if d, ok := parseRetryAfter(future); !ok || d <= 0 || d > 10*time.Second {
+		t.Errorf("Expected up to 10s for HTTP date, got %v (ok=%v)", d, ok)
+	}
+	if _, ok := parseRetryAfter("invalid"); ok {
+		t.Error("Expected invalid value to be rejected")
+	}
+	if _, ok := parseRetryAfter(""); ok {
+		t.Error("Expected empty value to be rejected")
+	}
+}
@@ -0,0 +1,200 @@
+package services
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"slices"
+	"strconv"
+	"sync"
+
+	"go-movie-explorer/models"
+)
+
+// SearchOptions は映画検索（/search/movie）の絞り込み条件
+type SearchOptions struct {
+	Year               int   // 公開年（いずれかの公開日がこの年の作品）
+	PrimaryReleaseYear int   // 初公開の年
+	IncludeAdult       *bool // 成人向け作品を含めるか（nilの場合はTMDBConfig.SearchIncludeAdult）
+}
+
+// apply は絞り込み条件をTMDBのクエリパラメータに設定する
+// TMDBはinclude_adult未指定の場合に成人向け作品を除外するため、含める場合のみ付与する
+func (o SearchOptions) apply(params url.Values, defaultIncludeAdult bool) {
+	if o.Year > 0 {
+		params.Set("year", strconv.Itoa(o.Year))
+	}
+	if o.PrimaryReleaseYear > 0 {
+		params.Set("primary_release_year", strconv.Itoa(o.PrimaryReleaseYear))
+	}
+	includeAdult := defaultIncludeAdult
+	if o.IncludeAdult != nil {
+		includeAdult = *o.IncludeAdult
+	}
+	if includeAdult {
+		params.Set("include_adult", "true")
+	}
+}
+
+// SearchMediaType は横断検索で結果に含める種類
+type SearchMediaType string
+
+const (
+	SearchAll        SearchMediaType = "" // 全ての種類（デフォルト）
+	SearchMovie      SearchMediaType = "movie"
+	SearchPerson     SearchMediaType = "person"
+	SearchCollection SearchMediaType = "collection"
+	SearchKeyword    SearchMediaType = "keyword"
+)
+
+// ParseSearchMediaType はmedia_typeパラメータを検証する（空の場合はSearchAll）
+func ParseSearchMediaType(value string) (SearchMediaType, bool) {
+	switch t := SearchMediaType(value); t {
+	case SearchAll, SearchMovie, SearchPerson, SearchCollection, SearchKeyword:
+		return t, true
+	}
+	return "", false
+}
+
+// --- 横断検索（/search/movie, /search/person, /search/collection, /search/keyword）---
+// TMDBの/search/multiはコレクション・キーワードを含まないため種類毎の検索を並行して行う
+// mediaTypeを指定した場合はその種類のみ検索する（件数も検索した種類のみで、他の種類は0）
+// 一部の種類の検索に失敗した場合はその種類を0件としてfailed_typesに記録し、残りの結果を返す
+// 全ての種類の検索に失敗した場合とクライアントが切断した場合はエラーを返す
+func (c *TMDBClient) Search(ctx context.Context, query string, mediaType SearchMediaType, page int) (*models.SearchResponse, error) {
+	if query == "" {
+		return nil, fmt.Errorf("検索クエリが指定されていません")
+	}
+
+	type search struct {
+		mediaType SearchMediaType
+		run       func() (totalPages, totalResults int, results []models.SearchResult, err error)
+	}
+	searches := []search{
+		{SearchMovie, func() (int, int, []models.SearchResult, error) {
+			movies, err := c.SearchMovies(ctx, query, page, SearchOptions{})
+			if err != nil {
+				return 0, 0, nil, err
+			}
+			results := make([]models.SearchResult, 0, len(movies.Results))
+			for _, m := range movies.Results {
+				results = append(results, models.SearchResult{
+					MediaType: string(SearchMovie), ID: m.ID, Title: m.Title, Overview: m.Overview,
+					ReleaseDate: m.ReleaseDate, PosterPath: m.PosterPath, VoteAverage: m.VoteAverage, Popularity: m.Popularity,
+				})
+			}
+			return movies.TotalPages, movies.TotalResults, results, nil
+		}},
+		{SearchPerson, func() (int, int, []models.SearchResult, error) {
+			people, err := c.SearchPeople(ctx, query, page)
+			if err != nil {
+				return 0, 0, nil, err
+			}
+			results := make([]models.SearchResult, 0, len(people.Results))
+			for _, p := range people.Results {
+				results = append(results, models.SearchResult{
+					MediaType: string(SearchPerson), ID: p.ID, Name: p.Name, ProfilePath: p.ProfilePath,
+					Popularity: p.Popularity, KnownForDepartment: p.KnownForDepartment, KnownFor: p.KnownFor,
+				})
+			}
+			return people.TotalPages, people.TotalResults, results, nil
+		}},
+		{SearchCollection, func() (int, int, []models.SearchResult, error) {
+			collections, err := c.searchCollections(ctx, query, page)
+			if err != nil {
+				return 0, 0, nil, err
+			}
+			results := make([]models.SearchResult, 0, len(collections.Results))
+			for _, col := range collections.Results {
+				results = append(results, models.SearchResult{
+					MediaType: string(SearchCollection), ID: col.ID, Name: col.Name, Overview: col.Overview,
+					PosterPath: col.PosterPath, BackdropPath: col.BackdropPath,
+				})
+			}
+			return collections.TotalPages, collections.TotalResults, results, nil
+		}},
+		{SearchKeyword, func() (int, int, []models.SearchResult, error) {
+			keywords, err := c.SearchKeywords(ctx, query, page)
+			if err != nil {
+				return 0, 0, nil, err
+			}
+			results := make([]models.SearchResult, 0, len(keywords.Results))
+			for _, k := range keywords.Results {
+				results = append(results, models.SearchResult{MediaType: string(SearchKeyword), ID: k.ID, Name: k.Name})
+			}
+			return keywords.TotalPages, keywords.TotalResults, results, nil
+		}},
+	}
+	if mediaType != SearchAll {
+		searches = slices.DeleteFunc(searches, func(s search) bool { return s.mediaType != mediaType })
+	}
+
+	type outcome struct {
+		totalPages, totalResults int
+		results                  []models.SearchResult
+		err                      error
+	}
+	outcomes := make([]outcome, len(searches))
+	var wg sync.WaitGroup
+	for i, s := range searches {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			o := &outcomes[i]
+			o.totalPages, o.totalResults, o.results, o.err = s.run()
+		}()
+	}
+	wg.Wait()
+
+	resp := &models.SearchResponse{Page: page, Results: []models.SearchResult{}}
+	var firstErr error
+	for i, o := range outcomes {
+		t := searches[i].mediaType
+		if o.err != nil {
+			// クライアントの切断は部分的な結果にせずそのまま返す
+			if errors.Is(o.err, ErrRequestCanceled) {
+				return nil, o.err
+			}
+			log.Printf("横断検索の%sの検索に失敗したため0件として返します: %v", t, o.err)
+			firstErr = cmp.Or(firstErr, o.err)
+			resp.FailedTypes = append(resp.FailedTypes, string(t))
+			continue
+		}
+		switch t {
+		case SearchMovie:
+			resp.Counts.Movie = o.totalResults
+		case SearchPerson:
+			resp.Counts.Person = o.totalResults
+		case SearchCollection:
+			resp.Counts.Collection = o.totalResults
+		case SearchKeyword:
+			resp.Counts.Keyword = o.totalResults
+		}
+		resp.TotalPages = max(resp.TotalPages, o.totalPages)
+		resp.TotalResults += o.totalResults
+		resp.Results = append(resp.Results, o.results...)
+	}
+	if len(resp.FailedTypes) == len(searches) {
+		return nil, firstErr
+	}
+	return resp, nil
+}
+
+// searchCollections はコレクションを名前で検索する（翻訳された概要が無い場合は英語で補完）
+func (c *TMDBClient) searchCollections(ctx context.Context, query string, page int) (*models.TmdbCollectionSearchResponse, error) {
+	params := pageParams(page)
+	params.Set("query", query)
+
+	var tmdbResp models.TmdbCollectionSearchResponse
+	if err := c.get(ctx, EndpointSearchCollections, "/search/collection", params, &tmdbResp); err != nil {
+		return nil, err
+	}
+	collections := tmdbResp.Results
+	c.fillOverviews(ctx, EndpointSearchCollections, "/search/collection", params, len(collections), func(i int) (int, *string) {
+		return collections[i].ID, &collections[i].Overview
+	})
+	return &tmdbResp, nil
+}
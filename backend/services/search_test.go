@@ -0,0 +1,158 @@
+package services
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"sync"
+	"testing"
+)
+
+// searchFixtures は種類毎の検索APIのレスポンス例
+var searchFixtures = map[string]string{
+	"/search/movie":      `{"page": 1, "total_pages": 3, "total_results": 45, "results": [{"id": 603, "title": "マトリックス", "overview": "ネオ"}]}`,
+	"/search/person":     `{"page": 1, "total_pages": 1, "total_results": 2, "results": [{"id": 6384, "name": "Keanu Reeves", "known_for": [{"id": 603, "title": "マトリックス", "media_type": "movie"}, {"id": 1, "name": "TV", "media_type": "tv"}]}]}`,
+	"/search/collection": `{"page": 1, "total_pages": 1, "total_results": 1, "results": [{"id": 2344, "name": "マトリックス（シリーズ）", "overview": ""}]}`,
+	"/search/keyword":    `{"page": 1, "total_pages": 1, "total_results": 0, "results": []}`,
+}
+
+// TestTMDBClient_Search - 横断検索の種類毎の件数と絞り込みのテスト
+func TestTMDBClient_Search(t *testing.T) {
+	client := newTestTMDBClient(t, func(w http.ResponseWriter, r *http.Request) {
+		fixture, ok := searchFixtures[r.URL.Path]
+		if !ok {
+			t.Errorf("Unexpected path: %s", r.URL.Path)
+		}
+		if r.URL.Query().Get("query") != "matrix" {
+			t.Errorf("Unexpected query: %s", r.URL.RawQuery)
+		}
+		if r.URL.Path == "/search/collection" && r.URL.Query().Get("language") == "en-US" {
+			w.Write([]byte(`{"results": [{"id": 2344, "overview": "English collection overview"}]}`))
+			return
+		}
+		w.Write([]byte(fixture))
+	})
+	ctx := WithLocale(context.Background(), Locale{Language: "ja"})
+
+	resp, err := client.Search(ctx, "matrix", SearchAll, 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if resp.Counts.Movie != 45 || resp.Counts.Person != 2 || resp.Counts.Collection != 1 || resp.Counts.Keyword != 0 {
+		t.Errorf("Unexpected counts: %+v", resp.Counts)
+	}
+	if resp.TotalResults != 48 || resp.TotalPages != 3 || len(resp.Results) != 3 {
+		t.Fatalf("Unexpected totals: %d results in %d pages, %d on page", resp.TotalResults, resp.TotalPages, len(resp.Results))
+	}
+	var types []string
+	for _, r := range resp.Results {
+		types = append(types, r.MediaType)
+	}
+	if types[0] != "movie" || types[1] != "person" || types[2] != "collection" {
+		t.Errorf("Unexpected media types: %v", types)
+	}
+	if len(resp.Results[1].KnownFor) != 1 || resp.Results[2].Overview != "English collection overview" {
+		t.Errorf("Unexpected results: %+v", resp.Results)
+	}
+
+	if resp.FailedTypes != nil {
+		t.Errorf("Expected no failed types, got %v", resp.FailedTypes)
+	}
+}
+
+// TestTMDBClient_Search_MediaType - media_typeを指定した場合はその種類のみ検索することのテスト
+func TestTMDBClient_Search_MediaType(t *testing.T) {
+	var paths []string
+	var mu sync.Mutex
+	client := newTestTMDBClient(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		paths = append(paths, r.URL.Path)
+		mu.Unlock()
+		w.Write([]byte(searchFixtures[r.URL.Path]))
+	})
+
+	resp, err := client.Search(context.Background(), "matrix", SearchPerson, 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(paths) != 1 || paths[0] != "/search/person" {
+		t.Errorf("Expected only person search, got %v", paths)
+	}
+	if resp.TotalResults != 2 || resp.TotalPages != 1 || len(resp.Results) != 1 || resp.Results[0].Name != "Keanu Reeves" {
+		t.Errorf("Expected only people, got %+v", resp)
+	}
+	if resp.Counts.Person != 2 || resp.Counts.Movie != 0 {
+		t.Errorf("Expected counts only for people, got %+v", resp.Counts)
+	}
+}
+
+// TestTMDBClient_Search_PartialFailure - 一部の種類の検索に失敗した場合は残りの結果を返すことのテスト
+func TestTMDBClient_Search_PartialFailure(t *testing.T) {
+	client := newTestTMDBClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/search/person" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(searchFixtures[r.URL.Path]))
+	})
+
+	// 部分的な結果は古いキャッシュではないためX-Data-Staleの対象にしない
+	ctx, tracker := WithStaleTracker(context.Background())
+	resp, err := client.Search(ctx, "matrix", SearchAll, 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(resp.FailedTypes) != 1 || resp.FailedTypes[0] != "person" {
+		t.Errorf("Expected person to be failed, got %v", resp.FailedTypes)
+	}
+	if resp.Counts.Person != 0 || resp.Counts.Movie != 45 || resp.TotalResults != 46 || len(resp.Results) != 2 {
+		t.Errorf("Unexpected partial results: %+v", resp)
+	}
+	if tracker.Degraded() {
+		t.Error("Expected partial results not to be marked as stale")
+	}
+
+	// 指定した種類の検索に失敗した場合はエラー
+	if _, err := client.Search(context.Background(), "matrix", SearchPerson, 1); err == nil {
+		t.Error("Expected error when the only search fails")
+	}
+}
+
+// TestTMDBClient_SearchMovies_Options - 映画検索の絞り込み条件と成人向け作品のデフォルトのテスト
+func TestTMDBClient_SearchMovies_Options(t *testing.T) {
+	var gotQuery url.Values
+	client := newTestTMDBClient(t, func(w http.ResponseWriter, r *http.Request) {
+		gotQuery = r.URL.Query()
+		w.Write([]byte(`{"page": 1, "total_pages": 1, "total_results": 0, "results": []}`))
+	})
+	ctx := WithLocale(context.Background(), Locale{Region: "JP"})
+	includeAdult, excludeAdult := true, false
+
+	tests := []struct {
+		name         string
+		defaultAdult bool
+		opts         SearchOptions
+		wantYear     string
+		wantPrimary  string
+		wantAdult    string
+	}{
+		{name: "デフォルトは成人向けを除外", opts: SearchOptions{Year: 1999}, wantYear: "1999"},
+		{name: "リクエストで成人向けを含める", opts: SearchOptions{PrimaryReleaseYear: 1999, IncludeAdult: &includeAdult}, wantPrimary: "1999", wantAdult: "true"},
+		{name: "サーバー設定で成人向けを含める", defaultAdult: true, opts: SearchOptions{}, wantAdult: "true"},
+		{name: "サーバー設定よりリクエストを優先", defaultAdult: true, opts: SearchOptions{IncludeAdult: &excludeAdult}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client.Config().SearchIncludeAdult = tt.defaultAdult
+			if _, err := client.SearchMovies(ctx, "matrix "+tt.name, 1, tt.opts); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if gotQuery.Get("year") != tt.wantYear || gotQuery.Get("primary_release_year") != tt.wantPrimary || gotQuery.Get("include_adult") != tt.wantAdult {
+				t.Errorf("Unexpected query: %s", gotQuery.Encode())
+			}
+			if gotQuery.Get("region") != "JP" {
+				t.Errorf("Expected region=JP, got %q", gotQuery.Get("region"))
+			}
+		})
+	}
+}
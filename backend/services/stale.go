@@ -0,0 +1,32 @@
+package services
+
+import (
+	"context"
+	"sync/atomic"
+)
+
+// StaleTracker はリクエスト処理中にTMDB障害によって古いキャッシュを返したかを記録する
+type StaleTracker struct {
+	degraded atomic.Bool
+}
+
+// Degraded は古いキャッシュを返した場合にtrueを返す
+func (t *StaleTracker) Degraded() bool {
+	return t.degraded.Load()
+}
+
+type staleTrackerKey struct{}
+
+// WithStaleTracker はStaleTrackerを持つコンテキストを返す
+// ミドルウェアでリクエスト毎に作成し、レスポンスヘッダーの付与に使用する
+func WithStaleTracker(ctx context.Context) (context.Context, *StaleTracker) {
+	tracker := &StaleTracker{}
+	return context.WithValue(ctx, staleTrackerKey{}, tracker), tracker
+}
+
+// markDegraded はコンテキストのStaleTrackerに古いキャッシュを返したことを記録する
+func markDegraded(ctx context.Context) {
+	if tracker, ok := ctx.Value(staleTrackerKey{}).(*StaleTracker); ok {
+		tracker.degraded.Store(true)
+	}
+}
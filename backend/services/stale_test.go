@@ -0,0 +1,147 @@
+package services
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newStaleTestClient は時刻を差し替え可能なキャッシュを持つクライアントを作成するヘルパー関数
+func newStaleTestClient(t *testing.T, handler http.HandlerFunc) (*TMDBClient, *time.Time) {
+	t.Helper()
+	client := newTestTMDBClient(t, handler)
+	client.Config().CacheTTLs = map[string]time.Duration{EndpointGenres: time.Minute}
+	client.Config().CacheStaleWhileRevalidate = time.Minute
+	client.Config().CacheStaleIfError = time.Hour
+
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	client.cache = NewResponseCache(10, time.Hour)
+	client.cache.now = func() time.Time { return now }
+	return client, &now
+}
+
+// TestTMDBClient_StaleIfError - TMDB障害時に最後に成功したレスポンスを返すことのテスト
+func TestTMDBClient_StaleIfError(t *testing.T) {
+	var failing atomic.Bool
+	client, now := newStaleTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if failing.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{"genres":[{"id":28,"name":"Action"}]}`))
+	})
+
+	if _, err := client.GetGenres(context.Background()); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// stale-while-revalidateの期間も過ぎた後にTMDBが停止
+	failing.Store(true)
+	*now = now.Add(10 * time.Minute)
+
+	ctx, tracker := WithStaleTracker(context.Background())
+	resp, err := client.GetGenres(ctx)
+	if err != nil {
+		t.Fatalf("Expected stale response instead of error, got %v", err)
+	}
+	if len(resp.Genres) != 1 || resp.Genres[0].Name != "Action" {
+		t.Errorf("Unexpected response: %+v", resp)
+	}
+	if !tracker.Degraded() {
+		t.Error("Expected response to be marked as degraded")
+	}
+
+	// 保持期間を過ぎた場合はエラーを返す
+	*now = now.Add(2 * time.Hour)
+	if _, err := client.GetGenres(context.Background()); err == nil {
+		t.Error("Expected error after stale retention expires")
+	}
+}
+
+// TestTMDBClient_StaleOnContextDeadline - 呼び出し元の期限切れでも古いキャッシュがあれば返すことのテスト
+func TestTMDBClient_StaleOnContextDeadline(t *testing.T) {
+	var slow atomic.Bool
+	client, now := newStaleTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if slow.Load() {
+			<-r.Context().Done()
+			return
+		}
+		w.Write([]byte(`{"genres":[{"id":28,"name":"Action"}]}`))
+	})
+
+	if _, err := client.GetGenres(context.Background()); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// stale-while-revalidateの期間も過ぎた後にTMDBの応答が遅延
+	slow.Store(true)
+	*now = now.Add(10 * time.Minute)
+
+	ctx, tracker := WithStaleTracker(context.Background())
+	ctx, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+	defer cancel()
+	resp, err := client.GetGenres(ctx)
+	if err != nil {
+		t.Fatalf("Expected stale response instead of error, got %v", err)
+	}
+	if len(resp.Genres) != 1 || resp.Genres[0].Name != "Action" {
+		t.Errorf("Unexpected response: %+v", resp)
+	}
+	if !tracker.Degraded() {
+		t.Error("Expected response to be marked as degraded")
+	}
+}
+
+// TestTMDBClient_StaleWhileRevalidate - TTL切れ直後は古いレスポンスを返しバックグラウンドで更新することのテスト
+func TestTMDBClient_StaleWhileRevalidate(t *testing.T) {
+	var requests atomic.Int32
+	client, now := newStaleTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) == 1 {
+			w.Write([]byte(`{"genres":[{"id":28,"name":"Action"}]}`))
+			return
+		}
+		w.Write([]byte(`{"genres":[{"id":28,"name":"アクション"}]}`))
+	})
+
+	if _, err := client.GetGenres(context.Background()); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	*now = now.Add(90 * time.Second)
+	ctx, tracker := WithStaleTracker(context.Background())
+	resp, err := client.GetGenres(ctx)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if resp.Genres[0].Name != "Action" {
+		t.Errorf("Expected stale response to be served immediately, got %+v", resp)
+	}
+	if tracker.Degraded() {
+		t.Error("Expected revalidating response not to be marked as degraded")
+	}
+
+	// バックグラウンド更新の完了を待つ
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		if body, ok := client.cache.Get("/genre/movie/list"); ok && string(body) != `{"genres":[{"id":28,"name":"Action"}]}` {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("Timed out waiting for background refresh")
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	resp, err = client.GetGenres(context.Background())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if resp.Genres[0].Name != "アクション" {
+		t.Errorf("Expected refreshed response, got %+v", resp)
+	}
+	if got := requests.Load(); got != 2 {
+		t.Errorf("Expected 2 upstream requests, got %d", got)
+	}
+}
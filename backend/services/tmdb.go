@@ -3,10 +3,16 @@ package services
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"log"
+	"maps"
 	"net/http"
 	"net/url"
 	"os"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -15,12 +21,10 @@ import (
 
 const BaseURL = "https://api.themoviedb.org/3"
 
-// シングルトンHTTPクライアント
+// シングルトンHTTPクライアント（Transportを全クライアントで共有する）
 var (
-	httpClient     *http.Client
-	clientOnce     sync.Once
-	tmdbAPIVersion string
-	versionMutex   sync.RWMutex
+	httpClient *http.Client
+	clientOnce sync.Once
 )
 
 // getHTTPClient はシングルトンパターンでHTTPクライアントを取得
@@ -57,42 +61,304 @@ func GetTMDBApiKey() string {
 	return os.Getenv("TMDB_API_KEY")
 }
 
-// GetTMDBAPIVersion はTMDB APIのバージョン情報を取得
-func GetTMDBAPIVersion() string {
-	versionMutex.RLock()
-	defer versionMutex.RUnlock()
-	if tmdbAPIVersion == "" {
-		return "default" // デフォルト値
+// MovieProvider はハンドラーが依存する映画データ取得のインターフェース
+// TMDB以外のデータソースやテスト用のフェイクに差し替える場合はこれを実装する
+// ctxにはリクエストのコンテキストを渡し、クライアント切断時に上流リクエストも中断させる
+type MovieProvider interface {
+	GetMovies(ctx context.Context, page int, opts DiscoverOptions) (*models.MoviesResponse, error)
+	GetMovieDetail(ctx context.Context, id int) (*models.MovieDetail, error)
+	SearchMovies(ctx context.Context, query string, page int, opts SearchOptions) (*models.MoviesResponse, error)
+	GetPopularMovies(ctx context.Context, page int) (*models.MoviesResponse, error)
+	GetNowPlayingMovies(ctx context.Context, page int) (*models.DatedMoviesResponse, error)
+	GetUpcomingMovies(ctx context.Context, page int) (*models.DatedMoviesResponse, error)
+	GetTopRatedMovies(ctx context.Context, page int) (*models.DatedMoviesResponse, error)
+	GetTrendingMovies(ctx context.Context, window TrendingWindow, page int) (*models.MoviesResponse, error)
+	GetMoviesByGenre(ctx context.Context, genres IDFilter, exclude []int, page int) (*models.GenreMovieListResponse, error)
+	GetGenres(ctx context.Context) (*models.GenreListResponse, error)
+	GetMovieCredits(ctx context.Context, id int) (*models.Credits, error)
+	GetMovieVideos(ctx context.Context, id int) (*models.MovieVideos, error)
+	GetMovieRecommendations(ctx context.Context, id, page int, filter MovieListFilter) (*models.MoviesResponse, error)
+	GetSimilarMovies(ctx context.Context, id, page int, filter MovieListFilter) (*models.MoviesResponse, error)
+	GetMovieWatchProviders(ctx context.Context, id int) (*models.MovieWatchProviders, error)
+	GetMovieReleases(ctx context.Context, id int) (*models.MovieReleases, error)
+	GetCollection(ctx context.Context, id int) (*models.Collection, error)
+	GetPerson(ctx context.Context, id int) (*models.Person, error)
+	GetPersonMovies(ctx context.Context, id int, order PersonMoviesSort) (*models.PersonMoviesResponse, error)
+	SearchPeople(ctx context.Context, query string, page int) (*models.PeopleSearchResponse, error)
+	GetMovieKeywords(ctx context.Context, id int) (*models.MovieKeywords, error)
+	SearchKeywords(ctx context.Context, query string, page int) (*models.KeywordSearchResponse, error)
+	GetKeywordMovies(ctx context.Context, id, page int) (*models.MoviesResponse, error)
+	Search(ctx context.Context, query string, mediaType SearchMediaType, page int) (*models.SearchResponse, error)
+}
+
+// エンドポイント名（エンドポイント毎のタイムアウト等の設定キー）
+const (
+	EndpointDiscover = "discover"
+	EndpointDetail   = "detail"
+	EndpointSearch   = "search"
+	EndpointPopular  = "popular"
+	EndpointGenres   = "genres"
+	EndpointCredits  = "credits"
+	EndpointVideos   = "videos"
+
+	EndpointRecommendations = "recommendations"
+	EndpointSimilar         = "similar"
+	EndpointWatchProviders  = "watch_providers"
+	EndpointReleaseDates    = "release_dates"
+	EndpointCollection      = "collection"
+
+	EndpointNowPlaying = "now_playing"
+	EndpointUpcoming   = "upcoming"
+	EndpointTopRated   = "top_rated"
+	EndpointTrending   = "trending"
+
+	EndpointPerson        = "person"
+	EndpointPersonCredits = "person_credits"
+	EndpointSearchPeople  = "search_people"
+
+	EndpointKeywords          = "keywords"
+	EndpointSearchKeywords    = "search_keywords"
+	EndpointSearchCollections = "search_collections"
+)
+
+// regionalEndpoints はTMDBがregionパラメータを解釈するエンドポイント
+// それ以外のエンドポイントにはregionを付けず、キャッシュキーが無駄に分かれないようにする
+var regionalEndpoints = map[string]bool{
+	EndpointDiscover: true,
+	EndpointSearch:   true,
+	EndpointPopular:  true,
+
+	EndpointNowPlaying: true,
+	EndpointUpcoming:   true,
+	EndpointTopRated:   true,
+}
+
+// localeFreeEndpoints は言語によってレスポンスが変わらないエンドポイント
+// languageを付けず、全言語で同じキャッシュエントリを共有する
+var localeFreeEndpoints = map[string]bool{
+	EndpointWatchProviders: true,
+	EndpointReleaseDates:   true,
+
+	// キーワードは英語名のみで翻訳されない
+	EndpointKeywords:       true,
+	EndpointSearchKeywords: true,
+}
+
+// overviewFallbackLanguage は翻訳された概要が空の場合に補完に使う言語
+const overviewFallbackLanguage = "en-US"
+
+// TMDBConfig はTMDBクライアントの設定
+type TMDBConfig struct {
+	BaseURL     string        // TMDB APIのベースURL（ローカルのフェイクサーバーを指す場合はTMDB_BASE_URLで変更）
+	APIKey      string        // TMDB APIのアクセストークン（Bearer）
+	HTTPClient  *http.Client  // 通常リクエスト用HTTPクライアント（nilの場合は共有Transportを使用）
+	Language    string        // TMDBに渡すlanguageパラメータのデフォルト（空の場合は指定しない）
+	Region      string        // TMDBに渡すregionパラメータのデフォルト（空の場合は指定しない）
+	Timeout     time.Duration // 通常リクエストのタイムアウト
+	PingTimeout time.Duration // Ping（/healthz）用のタイムアウト
+
+	// 映画検索で成人向け作品を含めるかのデフォルト（リクエストのinclude_adultが優先）
+	SearchIncludeAdult bool
+
+	// エンドポイント毎のタイムアウト（未設定のエンドポイントはTimeoutのみ適用）
+	EndpointTimeouts map[string]time.Duration
+
+	// ネットワークエラー・5xx・429時のリトライ設定（nilの場合はリトライしない）
+	Retry *RetryConfig
+
+	// サーキットブレーカー設定（nilの場合は無効）
+	Breaker *BreakerConfig
+
+	// レスポンスキャッシュ設定（CacheMaxEntriesが0の場合はキャッシュ無効）
+	CacheMaxEntries int
+	CacheTTLs       map[string]time.Duration // エンドポイント毎のTTL（未設定のエンドポイントはキャッシュしない）
+
+	// TTL切れ後、この期間内は古いレスポンスを即座に返しつつバックグラウンドで再取得する
+	CacheStaleWhileRevalidate time.Duration
+	// TTL切れ後、この期間内はTMDB障害時に古いレスポンスを返す（この期間を過ぎたエントリは破棄）
+	CacheStaleIfError time.Duration
+}
+
+// DefaultTMDBConfig は環境変数を元にしたデフォルトのTMDB設定を返す
+func DefaultTMDBConfig() *TMDBConfig {
+	baseURL := BaseURL
+	if value := strings.TrimSuffix(os.Getenv("TMDB_BASE_URL"), "/"); value != "" {
+		baseURL = value
+	}
+	return &TMDBConfig{
+		BaseURL:     baseURL,
+		APIKey:      GetTMDBApiKey(),
+		Language:    os.Getenv("TMDB_LANGUAGE"),
+		Region:      os.Getenv("TMDB_REGION"),
+		Timeout:     10 * time.Second,
+		PingTimeout: 5 * time.Second,
+
+		SearchIncludeAdult: os.Getenv("TMDB_SEARCH_INCLUDE_ADULT") == "true",
+
+		EndpointTimeouts: map[string]time.Duration{
+			EndpointSearch:  5 * time.Second, // 検索は入力毎に呼ばれるため短めにする
+			EndpointGenres:  5 * time.Second,
+			EndpointDetail:  8 * time.Second,
+			EndpointCredits: 8 * time.Second,
+			EndpointVideos:  5 * time.Second, // 詳細ページの予告編は必須ではないため短めにする
+
+			EndpointPerson:        8 * time.Second,
+			EndpointPersonCredits: 8 * time.Second,
+			EndpointSearchPeople:  5 * time.Second,
+
+			EndpointSearchKeywords:    5 * time.Second,
+			EndpointSearchCollections: 5 * time.Second,
+		},
+		Retry:           DefaultRetryConfig(),
+		Breaker:         DefaultBreakerConfig(),
+		CacheMaxEntries: 1000,
+		CacheTTLs: map[string]time.Duration{
+			EndpointGenres:   24 * time.Hour, // ジャンル一覧はほぼ変化しない
+			EndpointDetail:   time.Hour,
+			EndpointCredits:  time.Hour,
+			EndpointVideos:   time.Hour,
+			EndpointPopular:  10 * time.Minute,
+			EndpointDiscover: 5 * time.Minute,
+			EndpointSearch:   2 * time.Minute,
+
+			EndpointRecommendations: time.Hour,
+			EndpointSimilar:         time.Hour,
+			EndpointWatchProviders:  6 * time.Hour, // 配信状況の変化は日単位
+			EndpointReleaseDates:    6 * time.Hour,
+			EndpointCollection:      time.Hour,
+
+			EndpointNowPlaying: 30 * time.Minute,
+			EndpointUpcoming:   time.Hour,
+			EndpointTopRated:   time.Hour,
+			EndpointTrending:   15 * time.Minute,
+
+			EndpointPerson:        time.Hour,
+			EndpointPersonCredits: time.Hour,
+			EndpointSearchPeople:  2 * time.Minute,
+
+			EndpointKeywords:          6 * time.Hour,
+			EndpointSearchKeywords:    10 * time.Minute,
+			EndpointSearchCollections: 2 * time.Minute,
+		},
+		CacheStaleWhileRevalidate: 10 * time.Minute,
+		CacheStaleIfError:         24 * time.Hour,
 	}
-	return tmdbAPIVersion
 }
 
-// setTMDBAPIVersion はTMDB APIのバージョン情報を設定
-func setTMDBAPIVersion(version string) {
-	versionMutex.Lock()
-	defer versionMutex.Unlock()
-	tmdbAPIVersion = version
+// TMDBClient はTMDB APIのクライアント（MovieProviderの実装）
+// 設定ごとに独立したインスタンスを作成できるため、複数の設定を並行して扱える
+type TMDBClient struct {
+	config     *TMDBConfig
+	httpClient *http.Client
+	pingClient *http.Client
+	cache      *ResponseCache  // nilの場合はキャッシュ無効
+	breaker    *CircuitBreaker // nilの場合はサーキットブレーカー無効
+	inflight   inflightGroup   // 同一URLへの同時リクエストの集約
+
+	versionMutex sync.RWMutex
+	apiVersion   string
 }
 
-// --- TMDB疎通確認用Pinger（/healthz用）---
-type TmdbPinger struct{}
+var _ MovieProvider = (*TMDBClient)(nil)
+
+// NewTMDBClient は設定からTMDBクライアントを作成する
+// configがnilの場合はDefaultTMDBConfigを使用
+func NewTMDBClient(config *TMDBConfig) *TMDBClient {
+	if config == nil {
+		config = DefaultTMDBConfig()
+	}
+	if config.BaseURL == "" {
+		config.BaseURL = BaseURL
+	}
+
+	c := &TMDBClient{config: config}
+	if config.Breaker != nil {
+		c.breaker = NewCircuitBreaker(config.Breaker)
+	}
+	if config.CacheMaxEntries > 0 {
+		// 古いエントリはstale-while-revalidate・stale-if-errorのどちらにも使えるよう長い方の期間保持する
+		c.cache = NewResponseCache(config.CacheMaxEntries, max(config.CacheStaleWhileRevalidate, config.CacheStaleIfError))
+	}
+
+	// HTTPクライアントの決定（未指定の場合は共有Transportにタイムアウトだけ設定）
+	c.httpClient = config.HTTPClient
+	if c.httpClient == nil {
+		c.httpClient = getHTTPClient()
+		if config.Timeout > 0 && config.Timeout != c.httpClient.Timeout {
+			c.httpClient = &http.Client{Transport: c.httpClient.Transport, Timeout: config.Timeout}
+		}
+	}
+	baseTransport := c.httpClient.Transport
+
+	// リトライ設定がある場合はTransportをリトライ処理で包む（Timeoutはリトライを含めた全体に適用される）
+	if config.Retry != nil && config.Retry.MaxAttempts > 1 {
+		c.httpClient = &http.Client{
+			Transport: NewRetryTransport(baseTransport, config.Retry),
+			Timeout:   c.httpClient.Timeout,
+		}
+	}
+
+	// Ping用クライアントはリトライなしでTransportを共有し、タイムアウトのみ短くする
+	c.pingClient = getPingHTTPClient()
+	c.pingClient.Transport = baseTransport
+	if config.PingTimeout > 0 {
+		c.pingClient.Timeout = config.PingTimeout
+	}
+
+	return c
+}
+
+// Config はクライアントの設定を返す
+func (c *TMDBClient) Config() *TMDBConfig {
+	return c.config
+}
+
+// BreakerState はサーキットブレーカーの状態名を返す（無効の場合は"DISABLED"）
+func (c *TMDBClient) BreakerState() string {
+	if c.breaker == nil {
+		return "DISABLED"
+	}
+	return c.breaker.State().String()
+}
+
+// Cache はレスポンスキャッシュを返す（キャッシュ無効の場合はnil）
+func (c *TMDBClient) Cache() *ResponseCache {
+	return c.cache
+}
+
+// APIVersion はTMDB APIのバージョン情報を取得
+func (c *TMDBClient) APIVersion() string {
+	c.versionMutex.RLock()
+	defer c.versionMutex.RUnlock()
+	if c.apiVersion == "" {
+		return "default" // デフォルト値
+	}
+	return c.apiVersion
+}
+
+// setAPIVersion はTMDB APIのバージョン情報を設定
+func (c *TMDBClient) setAPIVersion(version string) {
+	c.versionMutex.Lock()
+	defer c.versionMutex.Unlock()
+	c.apiVersion = version
+}
 
-func (t *TmdbPinger) Name() string { return "TMDB" }
+// --- TMDB疎通確認（/healthz用）---
+func (c *TMDBClient) Name() string { return "TMDB" }
 
-func (t *TmdbPinger) Ping(ctx context.Context) error {
-	apiKey := GetTMDBApiKey()
-	if apiKey == "" {
+func (c *TMDBClient) Ping(ctx context.Context) error {
+	if c.config.APIKey == "" {
 		return fmt.Errorf("TMDB_API_KEYが設定されていません")
 	}
 
 	// 軽量なconfigurationエンドポイントを使用
-	req, err := http.NewRequestWithContext(ctx, "GET", BaseURL+"/configuration", nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", c.config.BaseURL+"/configuration", nil)
 	if err != nil {
 		return err
 	}
-	req.Header.Set("Authorization", "Bearer "+apiKey)
+	req.Header.Set("Authorization", "Bearer "+c.config.APIKey)
 
-	resp, err := getPingHTTPClient().Do(req)
+	resp, err := c.pingClient.Do(req)
 	if err != nil {
 		return err
 	}
@@ -103,101 +369,326 @@ func (t *TmdbPinger) Ping(ctx context.Context) error {
 	}
 
 	// TMDB APIバージョン情報を取得・保存
-	extractTMDBVersion(resp)
+	c.extractTMDBVersion(resp)
 
 	return nil
 }
 
 // extractTMDBVersion はレスポンスからTMDB APIのバージョン情報を抽出
-func extractTMDBVersion(resp *http.Response) {
+func (c *TMDBClient) extractTMDBVersion(resp *http.Response) {
 	// 1. レスポンスヘッダーからバージョン情報を取得
 	if apiVersion := resp.Header.Get("X-API-Version"); apiVersion != "" {
-		setTMDBAPIVersion(apiVersion)
+		c.setAPIVersion(apiVersion)
 		return
 	}
 
 	// 2. TMDBのAPI URLからバージョンを推定 (v3)
 	if version := resp.Header.Get("X-RateLimit-Limit"); version != "" {
 		// API v3 の特徴的なヘッダーが存在する場合
-		setTMDBAPIVersion("v3")
+		c.setAPIVersion("v3")
 		return
 	}
 
 	// 3. デフォルトとしてURLベースのバージョンを設定
-	setTMDBAPIVersion("v3")
+	c.setAPIVersion("v3")
 }
 
-// --- 映画一覧取得（/discover/movie）---
-func GetMoviesFromTMDB(page int) (*models.MoviesResponse, error) {
-	apiKey := GetTMDBApiKey()
-	if apiKey == "" {
-		return nil, fmt.Errorf("TMDB_API_KEYが設定されていません")
+// timeoutFor はエンドポイント毎のタイムアウトを返す（未設定の場合は0）
+func (c *TMDBClient) timeoutFor(endpoint string) time.Duration {
+	return c.config.EndpointTimeouts[endpoint]
+}
+
+// cacheTTLFor はエンドポイント毎のキャッシュTTLを返す（0の場合はキャッシュしない）
+func (c *TMDBClient) cacheTTLFor(endpoint string) time.Duration {
+	return c.config.CacheTTLs[endpoint]
+}
+
+// get はTMDB APIへGETリクエストを送り、レスポンスをoutにデコードする共通処理
+// キャッシュ対象のエンドポイントは有効期限内であればTMDBを呼ばずにキャッシュから返す
+func (c *TMDBClient) get(ctx context.Context, endpoint, path string, params url.Values, out interface{}) error {
+	if c.config.APIKey == "" {
+		return fmt.Errorf("TMDB_API_KEYが設定されていません")
 	}
 
-	// APIリクエストURL生成
-	url := fmt.Sprintf("%s/discover/movie?page=%d", BaseURL, page)
-	client := getHTTPClient()
+	// キャッシュキー（パス+エスケープ済みクエリ）の生成
+	// 言語・地域もクエリに含めるため、言語毎に別のエントリとしてキャッシュされる
+	params = maps.Clone(params)
+	if params == nil {
+		params = url.Values{}
+	}
+	loc := c.locale(ctx)
+	if loc.Language != "" && !localeFreeEndpoints[endpoint] && params.Get("language") == "" {
+		params.Set("language", loc.Language)
+	}
+	if loc.Region != "" && regionalEndpoints[endpoint] && params.Get("region") == "" {
+		params.Set("region", loc.Region)
+	}
+	key := path
+	if len(params) > 0 {
+		key += "?" + params.Encode()
+	}
+
+	ttl := c.cacheTTLFor(endpoint)
+	cacheable := c.cache != nil && ttl > 0
+
+	// TTL切れのエントリはTMDB障害時の代替として保持しておく
+	var stale []byte
+	if cacheable {
+		if cached, ok := c.cache.Lookup(key); ok {
+			switch {
+			case !cached.Stale:
+				if err := json.Unmarshal(cached.Value, out); err == nil {
+					return nil
+				}
+				// 壊れたエントリは破棄してTMDBから取得し直す
+				c.cache.Delete(key)
+			case cached.ExpiredFor < c.config.CacheStaleWhileRevalidate:
+				// stale-while-revalidate: 古いレスポンスを即座に返し、バックグラウンドで再取得
+				if err := json.Unmarshal(cached.Value, out); err == nil {
+					c.refreshInBackground(endpoint, key, ttl)
+					if cached.RefreshFailed {
+						markDegraded(ctx)
+					}
+					return nil
+				}
+			default:
+				stale = cached.Value
+			}
+		}
+	}
 
-	// HTTPリクエスト作成
-	req, err := http.NewRequest("GET", url, nil)
+	// 同一URLへの同時リクエストは1回のTMDB呼び出しにまとめる
+	body, err := c.inflight.Do(ctx, key, func(ctx context.Context) ([]byte, error) {
+		return c.fetch(ctx, endpoint, c.config.BaseURL+key)
+	})
 	if err != nil {
-		return nil, fmt.Errorf("リクエスト作成失敗: %w", err)
+		// 呼び出し元のコンテキストが終了した場合はTMDBの障害として扱わない
+		// 期限切れの場合は古いキャッシュがあればそれを返す
+		if ctx.Err() != nil && (errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)) {
+			if errors.Is(ctx.Err(), context.Canceled) {
+				return fmt.Errorf("%w: %w", ErrRequestCanceled, err)
+			}
+			err = &UpstreamError{Kind: UpstreamTimeout, Err: err}
+		}
+		// stale-if-error: TMDBが失敗・タイムアウトした場合は最後に成功したレスポンスを返す
+		// （TMDBから削除されたリソースの404はそのまま返す）
+		kind, _ := UpstreamErrorKindOf(err)
+		if stale != nil && kind != UpstreamNotFound && json.Unmarshal(stale, out) == nil {
+			log.Printf("TMDB呼び出し失敗のため古いキャッシュを返します (%s): %v", key, err)
+			c.cache.MarkRefreshFailed(key)
+			markDegraded(ctx)
+			return nil
+		}
+		return err
 	}
-	req.Header.Set("Authorization", "Bearer "+apiKey)
-	req.Header.Set("Accept", "application/json")
 
-	// TMDB API呼び出し
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("TMDB APIリクエスト失敗: %w", err)
+	if err := json.Unmarshal(body, out); err != nil {
+		return &UpstreamError{Kind: UpstreamBadGateway, Err: fmt.Errorf("TMDBレスポンスのデコード失敗: %w", err)}
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("TMDB APIエラー: status=%d", resp.StatusCode)
+	if cacheable {
+		c.cache.Set(key, body, ttl)
 	}
+	return nil
+}
 
-	// TMDBレスポンスを直接MoviesResponseにデコード
-	var moviesResp models.MoviesResponse
-	if err := json.NewDecoder(resp.Body).Decode(&moviesResp); err != nil {
-		return nil, fmt.Errorf("TMDBレスポンスのデコード失敗: %w", err)
+// locale はリクエストの言語・地域を返す（未指定の項目はTMDBConfigのデフォルトを使用）
+func (c *TMDBClient) locale(ctx context.Context) Locale {
+	loc := LocaleFromContext(ctx)
+	if loc.Language == "" {
+		loc.Language = c.config.Language
 	}
+	if loc.Region == "" {
+		loc.Region = c.config.Region
+	}
+	return loc
+}
 
-	return &moviesResp, nil
+// overviewFallbackContext は英語の概要で補完する必要がある場合に英語を指定したコンテキストを返す
+// 英語（または言語未指定）のリクエストの場合はfalseを返す
+func (c *TMDBClient) overviewFallbackContext(ctx context.Context) (context.Context, bool) {
+	loc := c.locale(ctx)
+	if loc.Language == "" || strings.HasPrefix(loc.Language, "en") {
+		return nil, false
+	}
+	loc.Language = overviewFallbackLanguage
+	return WithLocale(ctx, loc), true
 }
 
-// --- 映画詳細取得（/movie/{id}）---
-func GetMovieDetailFromTMDB(id int) (*models.MovieDetail, error) {
-	apiKey := GetTMDBApiKey()
-	if apiKey == "" {
-		return nil, fmt.Errorf("TMDB_API_KEYが設定されていません")
+// fillOverviews は一覧中の概要が空の映画を英語の概要で補完する
+// 同じページを英語で1回だけ取得し（キャッシュ対象）、IDで突き合わせる
+// overviewはi番目の映画のIDと概要へのポインタを返す。補完に失敗しても一覧はそのまま返す
+func (c *TMDBClient) fillOverviews(ctx context.Context, endpoint, path string, params url.Values, n int, overview func(i int) (int, *string)) {
+	fallbackCtx, ok := c.overviewFallbackContext(ctx)
+	if !ok {
+		return
+	}
+	missing := false
+	for i := 0; i < n && !missing; i++ {
+		_, text := overview(i)
+		missing = *text == ""
+	}
+	if !missing {
+		return
+	}
+
+	var english struct {
+		Results []struct {
+			ID       int    `json:"id"`
+			Overview string `json:"overview"`
+		} `json:"results"`
 	}
+	if err := c.get(fallbackCtx, endpoint, path, params, &english); err != nil {
+		log.Printf("英語の概要の取得に失敗 (%s): %v", path, err)
+		return
+	}
+	overviews := make(map[int]string, len(english.Results))
+	for _, m := range english.Results {
+		overviews[m.ID] = m.Overview
+	}
+	for i := 0; i < n; i++ {
+		if id, text := overview(i); *text == "" {
+			*text = overviews[id]
+		}
+	}
+}
 
-	// APIリクエストURL生成
-	url := fmt.Sprintf("%s/movie/%d", BaseURL, id)
-	client := getHTTPClient()
+// refreshInBackground はTTL切れのキャッシュエントリをバックグラウンドで再取得する
+// 同一キーの再取得や通常のリクエストとはinflightGroupで集約される
+func (c *TMDBClient) refreshInBackground(endpoint, key string, ttl time.Duration) {
+	go func() {
+		ctx := context.Background()
+		if c.config.Timeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, c.config.Timeout)
+			defer cancel()
+		}
+
+		body, err := c.inflight.Do(ctx, key, func(ctx context.Context) ([]byte, error) {
+			return c.fetch(ctx, endpoint, c.config.BaseURL+key)
+		})
+		if err == nil && !json.Valid(body) {
+			err = fmt.Errorf("TMDBレスポンスのデコード失敗: 不正なJSON")
+		}
+		if err != nil {
+			log.Printf("キャッシュのバックグラウンド更新に失敗 (%s): %v", key, err)
+			c.cache.MarkRefreshFailed(key)
+			return
+		}
+		c.cache.Set(key, body, ttl)
+	}()
+}
+
+// fetch はTMDB APIを呼び出してレスポンスボディを返す
+// ctxがキャンセルされるかエンドポイント毎の期限を過ぎると上流リクエストも中断する
+func (c *TMDBClient) fetch(ctx context.Context, endpoint, apiURL string) ([]byte, error) {
+	if timeout := c.timeoutFor(endpoint); timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
 
 	// HTTPリクエスト作成
-	req, err := http.NewRequest("GET", url, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", apiURL, nil)
 	if err != nil {
 		return nil, fmt.Errorf("リクエスト作成失敗: %w", err)
 	}
-	req.Header.Set("Authorization", "Bearer "+apiKey)
+	req.Header.Set("Authorization", "Bearer "+c.config.APIKey)
 	req.Header.Set("Accept", "application/json")
 
+	// サーキットブレーカーが開いている場合はTMDBを呼ばずに即座に失敗させる
+	outcome := outcomeFailure
+	if c.breaker != nil {
+		ticket, err := c.breaker.Allow()
+		if err != nil {
+			return nil, &UpstreamError{Kind: UpstreamUnavailable, Err: err}
+		}
+		defer func() { c.breaker.record(ticket, outcome) }()
+	}
+
 	// TMDB API呼び出し
-	resp, err := client.Do(req)
+	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("TMDB APIリクエスト失敗: %w", err)
+		if errors.Is(err, context.Canceled) {
+			outcome = outcomeIgnored // 呼び出し元の離脱はTMDBの障害として数えない
+		}
+		return nil, newTransportError(err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("TMDB APIエラー: status=%d", resp.StatusCode)
+		// 4xx（429以外）はTMDB自体は正常に応答しているため失敗として数えない
+		if resp.StatusCode < http.StatusInternalServerError && resp.StatusCode != http.StatusTooManyRequests {
+			outcome = outcomeSuccess
+		}
+		return nil, newStatusError(resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			outcome = outcomeIgnored
+		}
+		return nil, newTransportError(fmt.Errorf("TMDBレスポンスの読み込み失敗: %w", err))
+	}
+	outcome = outcomeSuccess
+	return body, nil
+}
+
+// pageParams はpageのみを持つクエリパラメータを作成
+func pageParams(page int) url.Values {
+	return url.Values{"page": {strconv.Itoa(page)}}
+}
+
+// nonNil はnilのスライスを空のスライスにする（JSONでnullではなく[]を返すため）
+func nonNil[T any](values []T) []T {
+	if values == nil {
+		return []T{}
+	}
+	return values
+}
+
+// --- 映画一覧取得（/discover/movie）---
+func (c *TMDBClient) GetMovies(ctx context.Context, page int, opts DiscoverOptions) (*models.MoviesResponse, error) {
+	params := pageParams(page)
+	if err := opts.apply(params, c.locale(ctx).Region); err != nil {
+		return nil, err
+	}
+
+	// TMDBレスポンスを直接MoviesResponseにデコード
+	var moviesResp models.MoviesResponse
+	if err := c.get(ctx, EndpointDiscover, "/discover/movie", params, &moviesResp); err != nil {
+		return nil, err
 	}
+	c.fillMovieOverviews(ctx, EndpointDiscover, "/discover/movie", params, moviesResp.Results)
+	return &moviesResp, nil
+}
+
+// fillMovieOverviews はmodels.Movieの一覧の空の概要を英語で補完する
+func (c *TMDBClient) fillMovieOverviews(ctx context.Context, endpoint, path string, params url.Values, movies []models.Movie) {
+	c.fillOverviews(ctx, endpoint, path, params, len(movies), func(i int) (int, *string) {
+		return movies[i].ID, &movies[i].Overview
+	})
+}
+
+// --- 映画詳細取得（/movie/{id}）---
+func (c *TMDBClient) GetMovieDetail(ctx context.Context, id int) (*models.MovieDetail, error) {
 	var tmdbResp models.TmdbMovieDetailResponse
-	if err := json.NewDecoder(resp.Body).Decode(&tmdbResp); err != nil {
-		return nil, fmt.Errorf("TMDBレスポンスのデコード失敗: %w", err)
+	path := fmt.Sprintf("/movie/%d", id)
+	// リクエスト地域の年齢制限を求めるため公開日情報も1回のリクエストで取得する
+	params := url.Values{"append_to_response": {"release_dates"}}
+	if err := c.get(ctx, EndpointDetail, path, params, &tmdbResp); err != nil {
+		return nil, err
+	}
+
+	// 翻訳された概要が無い場合は英語の概要で補完
+	if fallbackCtx, ok := c.overviewFallbackContext(ctx); ok && tmdbResp.Overview == "" {
+		var english models.TmdbMovieDetailResponse
+		if err := c.get(fallbackCtx, EndpointDetail, path, params, &english); err != nil {
+			log.Printf("英語の概要の取得に失敗 (%s): %v", path, err)
+		} else {
+			tmdbResp.Overview = english.Overview
+		}
 	}
 
 	// TMDBのレスポンスを独自のMovieDetailに変換
@@ -216,13 +707,26 @@ func GetMovieDetailFromTMDB(id int) (*models.MovieDetail, error) {
 		Budget:           tmdbResp.Budget,
 		OriginCountry:    tmdbResp.OriginCountry,
 		OriginalLanguage: tmdbResp.OriginalLanguage,
+		Certification:    certification(tmdbResp.ReleaseDates.Results, c.locale(ctx).Region),
+
+		Adult:               tmdbResp.Adult,
+		Runtime:             tmdbResp.Runtime,
+		Revenue:             tmdbResp.Revenue,
+		Tagline:             tmdbResp.Tagline,
+		Status:              tmdbResp.Status,
+		VoteAverage:         tmdbResp.VoteAverage,
+		VoteCount:           tmdbResp.VoteCount,
+		SpokenLanguages:     nonNil(tmdbResp.SpokenLanguages),
+		ProductionCompanies: nonNil(tmdbResp.ProductionCompanies),
+		ProductionCountries: nonNil(tmdbResp.ProductionCountries),
+		BelongsToCollection: newMovieCollection(tmdbResp.BelongsToCollection),
 	}, nil
 }
 
 // --- 映画検索（/search/movie）---
-func SearchMoviesFromTMDB(query string, page int) (*models.MoviesResponse, error) {
-	apiKey := GetTMDBApiKey()
-	if apiKey == "" {
+// 地域（region）はリクエストのロケールから付与され、その地域の公開日で公開年を判定する
+func (c *TMDBClient) SearchMovies(ctx context.Context, query string, page int, opts SearchOptions) (*models.MoviesResponse, error) {
+	if c.config.APIKey == "" {
 		return nil, fmt.Errorf("TMDB_API_KEYが設定されていません")
 	}
 
@@ -230,118 +734,69 @@ func SearchMoviesFromTMDB(query string, page int) (*models.MoviesResponse, error
 		return nil, fmt.Errorf("検索クエリが指定されていません")
 	}
 
-	// APIリクエストURL生成（クエリパラメータをエスケープ）
-	apiURL := fmt.Sprintf("%s/search/movie?query=%s&page=%d", BaseURL, url.QueryEscape(query), page)
-	client := getHTTPClient()
-
-	// HTTPリクエスト作成
-	req, err := http.NewRequest("GET", apiURL, nil)
-	if err != nil {
-		return nil, fmt.Errorf("リクエスト作成失敗: %w", err)
-	}
-	req.Header.Set("Authorization", "Bearer "+apiKey)
-	req.Header.Set("Accept", "application/json")
-
-	// TMDB API呼び出し
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("TMDB APIリクエスト失敗: %w", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("TMDB APIエラー: status=%d", resp.StatusCode)
-	}
+	params := pageParams(page)
+	params.Set("query", query)
+	opts.apply(params, c.config.SearchIncludeAdult)
 
 	// TMDBレスポンスを直接MoviesResponseにデコード
 	var moviesResp models.MoviesResponse
-	if err := json.NewDecoder(resp.Body).Decode(&moviesResp); err != nil {
-		return nil, fmt.Errorf("TMDBレスポンスのデコード失敗: %w", err)
+	if err := c.get(ctx, EndpointSearch, "/search/movie", params, &moviesResp); err != nil {
+		return nil, err
 	}
-
+	c.fillMovieOverviews(ctx, EndpointSearch, "/search/movie", params, moviesResp.Results)
 	return &moviesResp, nil
 }
 
 // --- 人気映画ランキング取得（/movie/popular）---
-// func GetPopularMoviesFromTMDB(page int) (*models.MoviesResponse, error) {
-// 	// TODO: 人気映画ランキングAPIの実装予定
-// 	// 例: /movie/popular?page=...
-// 	return nil, nil
-// }
-
-func GetPopularMoviesFromTMDB(page int) (*models.MoviesResponse, error) {
-	apiKey := GetTMDBApiKey()
-	if apiKey == "" {
-		return nil, fmt.Errorf("TMDB_API_KEYが設定されていません")
-	}
-
-	url := fmt.Sprintf("%s/movie/popular?page=%d", BaseURL, page)
-
-	req, err := http.NewRequest("GET", url, nil)
-	if err != nil {
-		return nil, fmt.Errorf("リクエスト作成失敗: %w", err)
-	}
-
-	req.Header.Set("Accept", "application/json")
-	req.Header.Set("Authorization", "Bearer "+apiKey)
-
-	client := getHTTPClient()
-
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("TMDB APIリクエスト失敗: %w", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("TMDB APIエラー: status=%d", resp.StatusCode)
-	}
-
+func (c *TMDBClient) GetPopularMovies(ctx context.Context, page int) (*models.MoviesResponse, error) {
 	var tmdbResp models.MoviesResponse
-	if err := json.NewDecoder(resp.Body).Decode(&tmdbResp); err != nil {
-		return nil, fmt.Errorf("レスポンスデコード失敗: %w", err)
+	params := pageParams(page)
+	if err := c.get(ctx, EndpointPopular, "/movie/popular", params, &tmdbResp); err != nil {
+		return nil, err
 	}
-
+	c.fillMovieOverviews(ctx, EndpointPopular, "/movie/popular", params, tmdbResp.Results)
 	return &tmdbResp, nil
 }
 
 // --- ジャンル別映画取得（/discover/movie?with_genres=）---
-func GetMoviesByGenreFromTMDB(genreID, page int) (*models.GenreMovieListResponse, error) {
-	apiKey := GetTMDBApiKey()
-	if apiKey == "" {
-		return nil, fmt.Errorf("TMDB_API_KEYが設定されていません")
+// genresは全て含む（28,12）またはいずれかを含む（28|35）ジャンル、excludeは除外するジャンル
+// ジャンル名はジャンル一覧から引き、一覧に無いIDの場合はErrUnknownGenreを返す
+func (c *TMDBClient) GetMoviesByGenre(ctx context.Context, genres IDFilter, exclude []int, page int) (*models.GenreMovieListResponse, error) {
+	if len(genres.IDs) == 0 {
+		return nil, ErrGenreRequired
 	}
-
-	url := fmt.Sprintf("%s/discover/movie?with_genres=%d&page=%d", BaseURL, genreID, page)
-
-	req, err := http.NewRequest("GET", url, nil)
-	if err != nil {
-		return nil, fmt.Errorf("リクエスト作成失敗: %w", err)
-	}
-
-	req.Header.Set("Authorization", "Bearer "+apiKey)
-	req.Header.Set("Accept", "application/json")
-	client := getHTTPClient()
-
-	resp, err := client.Do(req)
+	included, excluded, err := c.resolveGenres(ctx, genres.IDs, exclude)
 	if err != nil {
-		return nil, fmt.Errorf("TMDb APIリクエスト失敗: %w", err)
+		return nil, err
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("TMDb APIエラー: %s", resp.Status)
+	params := pageParams(page)
+	params.Set("with_genres", genres.String())
+	if len(exclude) > 0 {
+		params.Set("without_genres", joinInts(exclude, ","))
 	}
 
 	var tmdbResp models.TMDBGenreMovieList
-	if err := json.NewDecoder(resp.Body).Decode(&tmdbResp); err != nil {
-		return nil, fmt.Errorf("TMDbレスポンスのデコード失敗: %w", err)
+	if err := c.get(ctx, EndpointDiscover, "/discover/movie", params, &tmdbResp); err != nil {
+		return nil, err
 	}
 
 	movies := append([]models.MovieByGenre{}, tmdbResp.Results...)
+	c.fillOverviews(ctx, EndpointDiscover, "/discover/movie", params, len(movies), func(i int) (int, *string) {
+		return movies[i].ID, &movies[i].Overview
+	})
 
+	match := "all"
+	if genres.Any {
+		match = "any"
+	}
 	return &models.GenreMovieListResponse{
-		GenreID:      genreID,
+		GenreID:        genres.IDs[0],
+		GenreIDs:       genres.IDs,
+		Match:          match,
+		Genres:         included,
+		ExcludedGenres: excluded,
+
 		Page:         tmdbResp.Page,
 		PerPage:      len(movies),
 		TotalPages:   tmdbResp.TotalPages,
@@ -351,37 +806,11 @@ func GetMoviesByGenreFromTMDB(genreID, page int) (*models.GenreMovieListResponse
 }
 
 // --- ジャンル一覧取得（/genre/movie/list）---
-func GetGenresFromTMDB() (*models.GenreListResponse, error) {
-	apiKey := GetTMDBApiKey()
-	if apiKey == "" {
-		return nil, fmt.Errorf("TMDB_API_KEYが設定されていません")
-	}
-	url := fmt.Sprintf("%s/genre/movie/list", BaseURL)
-	client := getHTTPClient()
-
-	req, err := http.NewRequest("GET", url, nil)
-	if err != nil {
-		return nil, fmt.Errorf("リクエスト作成失敗: %w", err)
-	}
-	req.Header.Set("Authorization", "Bearer "+apiKey)
-	req.Header.Set("Accept", "application/json")
-
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("TMDB APPリクエスト失敗: %w", err)
-	}
-
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("TMDB APIエラー: status=%d", resp.StatusCode)
-	}
-
+func (c *TMDBClient) GetGenres(ctx context.Context) (*models.GenreListResponse, error) {
 	// TMDBレスポンスを直接GenreListResponseにデコード
 	var tmdbResp models.GenreListResponse
-	if err := json.NewDecoder(resp.Body).Decode(&tmdbResp); err != nil {
-		return nil, fmt.Errorf("TMDBレスポンスのデコード失敗: %w", err)
+	if err := c.get(ctx, EndpointGenres, "/genre/movie/list", nil, &tmdbResp); err != nil {
+		return nil, err
 	}
-
 	return &tmdbResp, nil
 }
@@ -2,15 +2,17 @@ package services
 
 import (
 	"context"
+	"errors"
 	"net/http"
+	"net/http/httptest"
 	"os"
 	"sync"
 	"testing"
 	"time"
 )
 
-// TestGetMoviesFromTMDB_NoAPIKey - APIキーなしのテスト
-func TestGetMoviesFromTMDB_NoAPIKey(t *testing.T) {
+// TestGetMovies_NoAPIKey - APIキーなしのテスト
+func TestGetMovies_NoAPIKey(t *testing.T) {
 	// APIキーを一時的に削除
 	originalKey := os.Getenv("TMDB_API_KEY")
 	os.Unsetenv("TMDB_API_KEY")
@@ -20,7 +22,7 @@ func TestGetMoviesFromTMDB_NoAPIKey(t *testing.T) {
 		}
 	}()
 
-	_, err := GetMoviesFromTMDB(1)
+	_, err := NewTMDBClient(nil).GetMovies(context.Background(), 1, DiscoverOptions{})
 	if err == nil {
 		t.Error("Expected error when TMDB_API_KEY is not set")
 	}
@@ -30,8 +32,8 @@ func TestGetMoviesFromTMDB_NoAPIKey(t *testing.T) {
 	}
 }
 
-// TestGetMovieDetailFromTMDB_NoAPIKey - 映画詳細取得のAPIキーなしテスト
-func TestGetMovieDetailFromTMDB_NoAPIKey(t *testing.T) {
+// TestGetMovieDetail_NoAPIKey - 映画詳細取得のAPIキーなしテスト
+func TestGetMovieDetail_NoAPIKey(t *testing.T) {
 	// APIキーを一時的に削除
 	originalKey := os.Getenv("TMDB_API_KEY")
 	os.Unsetenv("TMDB_API_KEY")
@@ -41,7 +43,7 @@ func TestGetMovieDetailFromTMDB_NoAPIKey(t *testing.T) {
 		}
 	}()
 
-	_, err := GetMovieDetailFromTMDB(123)
+	_, err := NewTMDBClient(nil).GetMovieDetail(context.Background(), 123)
 	if err == nil {
 		t.Error("Expected error when TMDB_API_KEY is not set")
 	}
@@ -51,13 +53,13 @@ func TestGetMovieDetailFromTMDB_NoAPIKey(t *testing.T) {
 	}
 }
 
-// TestSearchMoviesFromTMDB_EmptyQuery - 映画検索の空クエリテスト
-func TestSearchMoviesFromTMDB_EmptyQuery(t *testing.T) {
+// TestSearchMovies_EmptyQuery - 映画検索の空クエリテスト
+func TestSearchMovies_EmptyQuery(t *testing.T) {
 	// APIキーを設定
 	os.Setenv("TMDB_API_KEY", "test-key")
 	defer os.Unsetenv("TMDB_API_KEY")
 
-	_, err := SearchMoviesFromTMDB("", 1)
+	_, err := NewTMDBClient(nil).SearchMovies(context.Background(), "", 1, SearchOptions{})
 	if err == nil {
 		t.Error("Expected error when query is empty")
 	}
@@ -67,8 +69,8 @@ func TestSearchMoviesFromTMDB_EmptyQuery(t *testing.T) {
 	}
 }
 
-// TestSearchMoviesFromTMDB_NoAPIKey - 映画検索のAPIキーなしテスト
-func TestSearchMoviesFromTMDB_NoAPIKey(t *testing.T) {
+// TestSearchMovies_NoAPIKey - 映画検索のAPIキーなしテスト
+func TestSearchMovies_NoAPIKey(t *testing.T) {
 	// APIキーを一時的に削除
 	originalKey := os.Getenv("TMDB_API_KEY")
 	os.Unsetenv("TMDB_API_KEY")
@@ -78,7 +80,7 @@ func TestSearchMoviesFromTMDB_NoAPIKey(t *testing.T) {
 		}
 	}()
 
-	_, err := SearchMoviesFromTMDB("test", 1)
+	_, err := NewTMDBClient(nil).SearchMovies(context.Background(), "test", 1, SearchOptions{})
 	if err == nil {
 		t.Error("Expected error when TMDB_API_KEY is not set")
 	}
@@ -88,9 +90,9 @@ func TestSearchMoviesFromTMDB_NoAPIKey(t *testing.T) {
 	}
 }
 
-// TestTmdbPinger - TMDBヘルスチェックのテスト
-func TestTmdbPinger(t *testing.T) {
-	pinger := &TmdbPinger{}
+// TestTMDBClientPing - TMDBヘルスチェックのテスト
+func TestTMDBClientPing(t *testing.T) {
+	pinger := NewTMDBClient(nil)
 
 	// Name() メソッドのテスト
 	if pinger.Name() != "TMDB" {
@@ -107,7 +109,7 @@ func TestTmdbPinger(t *testing.T) {
 	}()
 
 	ctx := context.Background()
-	err := pinger.Ping(ctx)
+	err := NewTMDBClient(nil).Ping(ctx)
 	if err == nil {
 		t.Error("Expected error when TMDB_API_KEY is not set")
 	}
@@ -118,6 +120,7 @@ func TestTmdbPinger(t *testing.T) {
 
 	// タイムアウトのテスト
 	os.Setenv("TMDB_API_KEY", "test-key")
+	pinger = NewTMDBClient(nil)
 	ctxWithTimeout, cancel := context.WithTimeout(context.Background(), 1*time.Millisecond)
 	defer cancel()
 	err = pinger.Ping(ctxWithTimeout)
@@ -214,8 +217,8 @@ func TestHTTPClientConcurrency(t *testing.T) {
 	}
 }
 
-// TestGetGenresFromTMDB - ジャンル一覧取得のAPIキーなしテスト
-func TestGetGenresFromTMDB_NoAPIKey(t *testing.T) {
+// TestGetGenres_NoAPIKey - ジャンル一覧取得のAPIキーなしテスト
+func TestGetGenres_NoAPIKey(t *testing.T) {
 	// APIキーを一時的に削除
 	originalKey := os.Getenv("TMDB_API_KEY")
 	os.Unsetenv("TMDB_API_KEY")
@@ -225,7 +228,7 @@ func TestGetGenresFromTMDB_NoAPIKey(t *testing.T) {
 		}
 	}()
 
-	_, err := GetGenresFromTMDB()
+	_, err := NewTMDBClient(nil).GetGenres(context.Background())
 	if err == nil {
 		t.Error("Expected error when TMDB_API_KEY is not set")
 	}
@@ -234,3 +237,335 @@ func TestGetGenresFromTMDB_NoAPIKey(t *testing.T) {
 		t.Errorf("Expected error message '%s', got '%s'", expectedMsg, err.Error())
 	}
 }
+
+// newTestTMDBClient はフェイクTMDBサーバーを指すクライアントを作成するヘルパー関数
+func newTestTMDBClient(t *testing.T, handler http.HandlerFunc) *TMDBClient {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	return NewTMDBClient(&TMDBConfig{
+		BaseURL:    server.URL,
+		APIKey:     "test-key",
+		HTTPClient: server.Client(),
+	})
+}
+
+// TestTMDBClient_FakeServer - フェイクサーバーに対するリクエスト内容のテスト
+func TestTMDBClient_FakeServer(t *testing.T) {
+	var gotPath, gotQuery, gotAuth string
+	client := newTestTMDBClient(t, func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotQuery = r.URL.RawQuery
+		gotAuth = r.Header.Get("Authorization")
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"page":3,"total_pages":5,"total_results":100,"results":[{"id":1,"title":"Fake Movie","overview":"Fake overview"}]}`))
+	})
+	client.Config().Language = "ja-JP"
+
+	resp, err := client.SearchMovies(context.Background(), "time travel", 3, SearchOptions{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if gotPath != "/search/movie" {
+		t.Errorf("Expected path '/search/movie', got '%s'", gotPath)
+	}
+	if gotAuth != "Bearer test-key" {
+		t.Errorf("Expected Authorization 'Bearer test-key', got '%s'", gotAuth)
+	}
+	expectedQuery := "language=ja-JP&page=3&query=time+travel"
+	if gotQuery != expectedQuery {
+		t.Errorf("Expected query '%s', got '%s'", expectedQuery, gotQuery)
+	}
+	if resp.Page != 3 || len(resp.Results) != 1 || resp.Results[0].Title != "Fake Movie" {
+		t.Errorf("Unexpected response: %+v", resp)
+	}
+}
+
+// TestTMDBClient_MovieDetail - 映画詳細の変換テスト
+func TestTMDBClient_MovieDetail(t *testing.T) {
+	client := newTestTMDBClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/movie/550" {
+			t.Errorf("Expected path '/movie/550', got '%s'", r.URL.Path)
+		}
+		w.Write([]byte(`{"id":550,"title":"Fight Club","genres":[{"id":18,"name":"Drama"}],"budget":63000000,
+			"runtime":139,"revenue":100853753,"tagline":"Mischief. Mayhem. Soap.","status":"Released",
+			"vote_average":8.4,"vote_count":30000,"adult":false,
+			"spoken_languages":[{"english_name":"English","iso_639_1":"en","name":"English"}],
+			"production_companies":[{"id":508,"logo_path":"/regency.png","name":"Regency Enterprises","origin_country":"US"}],
+			"production_countries":[{"iso_3166_1":"US","name":"United States of America"}]}`))
+	})
+
+	detail, err := client.GetMovieDetail(context.Background(), 550)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if detail.ID != 550 || detail.Title != "Fight Club" || detail.Budget != 63000000 {
+		t.Errorf("Unexpected detail: %+v", detail)
+	}
+	if len(detail.Genres) != 1 || detail.Genres[0].Name != "Drama" {
+		t.Errorf("Unexpected genres: %+v", detail.Genres)
+	}
+	if detail.Runtime != 139 || detail.Revenue != 100853753 || detail.Tagline == "" || detail.Status != "Released" {
+		t.Errorf("Unexpected runtime/revenue/tagline/status: %+v", detail)
+	}
+	if detail.VoteAverage != 8.4 || detail.VoteCount != 30000 {
+		t.Errorf("Unexpected votes: %v / %d", detail.VoteAverage, detail.VoteCount)
+	}
+	if len(detail.SpokenLanguages) != 1 || len(detail.ProductionCompanies) != 1 || detail.ProductionCompanies[0].Name != "Regency Enterprises" || len(detail.ProductionCountries) != 1 {
+		t.Errorf("Unexpected languages/companies/countries: %+v", detail)
+	}
+	if detail.BelongsToCollection != nil {
+		t.Errorf("Expected no collection, got %+v", detail.BelongsToCollection)
+	}
+}
+
+// TestTMDBClient_MovieDetail_Collection - シリーズ作品のコレクションと空のリストの変換テスト
+func TestTMDBClient_MovieDetail_Collection(t *testing.T) {
+	client := newTestTMDBClient(t, func(w http.ResponseWriter, r *http.Request) {
+		// シリーズ中の位置はinclude=collection指定時にハンドラーが取得する
+		if r.URL.Path != "/movie/120" {
+			t.Errorf("Unexpected path: %s", r.URL.Path)
+		}
+		w.Write([]byte(`{"id":120,"title":"The Lord of the Rings","overview":"Overview",
+			"belongs_to_collection":{"id":119,"name":"The Lord of the Rings Collection","poster_path":"/p.jpg","backdrop_path":"/b.jpg"}}`))
+	})
+
+	detail, err := client.GetMovieDetail(context.Background(), 120)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if detail.BelongsToCollection == nil || detail.BelongsToCollection.ID != 119 || detail.BelongsToCollection.Part != 0 {
+		t.Errorf("Expected collection 119 without part, got %+v", detail.BelongsToCollection)
+	}
+	// TMDBが返さなかったリストはnullではなく空のリストにする
+	if detail.SpokenLanguages == nil || detail.ProductionCompanies == nil || detail.ProductionCountries == nil {
+		t.Errorf("Expected empty lists instead of nil: %+v", detail)
+	}
+}
+
+// TestTMDBClient_ErrorStatus - TMDBが200以外を返した場合のテスト
+func TestTMDBClient_ErrorStatus(t *testing.T) {
+	client := newTestTMDBClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+
+	_, err := client.GetGenres(context.Background())
+	if err == nil {
+		t.Fatal("Expected error for non-200 status")
+	}
+	expectedMsg := "TMDB APIエラー: status=401"
+	if err.Error() != expectedMsg {
+		t.Errorf("Expected error message '%s', got '%s'", expectedMsg, err.Error())
+	}
+	if kind, ok := UpstreamErrorKindOf(err); !ok || kind != UpstreamUnauthorized {
+		t.Errorf("Expected UpstreamUnauthorized, got %v (ok=%v)", kind, ok)
+	}
+}
+
+// TestTMDBClient_UpstreamErrorKinds - TMDBのステータス・通信エラーが種類付きのエラーになることのテスト
+func TestTMDBClient_UpstreamErrorKinds(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		expected   UpstreamErrorKind
+	}{
+		{name: "存在しない映画", statusCode: http.StatusNotFound, expected: UpstreamNotFound},
+		{name: "権限不足", statusCode: http.StatusForbidden, expected: UpstreamUnauthorized},
+		{name: "レート制限", statusCode: http.StatusTooManyRequests, expected: UpstreamRateLimited},
+		{name: "メンテナンス中", statusCode: http.StatusServiceUnavailable, expected: UpstreamUnavailable},
+		{name: "ゲートウェイタイムアウト", statusCode: http.StatusGatewayTimeout, expected: UpstreamTimeout},
+		{name: "内部エラー", statusCode: http.StatusInternalServerError, expected: UpstreamBadGateway},
+		{name: "不正なパラメータ", statusCode: http.StatusUnprocessableEntity, expected: UpstreamBadGateway},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newTestTMDBClient(t, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.statusCode)
+			})
+
+			_, err := client.GetMovieDetail(context.Background(), 999999999)
+			kind, ok := UpstreamErrorKindOf(err)
+			if !ok || kind != tt.expected {
+				t.Errorf("Expected %s, got %v (ok=%v, err=%v)", tt.expected, kind, ok, err)
+			}
+		})
+	}
+
+	t.Run("不正なJSON", func(t *testing.T) {
+		client := newTestTMDBClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte("<html>"))
+		})
+		_, err := client.GetMovieDetail(context.Background(), 1)
+		if kind, ok := UpstreamErrorKindOf(err); !ok || kind != UpstreamBadGateway {
+			t.Errorf("Expected UpstreamBadGateway, got %v (ok=%v, err=%v)", kind, ok, err)
+		}
+	})
+
+	t.Run("タイムアウト", func(t *testing.T) {
+		client := newTestTMDBClient(t, func(w http.ResponseWriter, r *http.Request) {
+			<-r.Context().Done()
+		})
+		client.Config().EndpointTimeouts = map[string]time.Duration{EndpointDetail: 10 * time.Millisecond}
+		_, err := client.GetMovieDetail(context.Background(), 1)
+		if kind, ok := UpstreamErrorKindOf(err); !ok || kind != UpstreamTimeout {
+			t.Errorf("Expected UpstreamTimeout, got %v (ok=%v, err=%v)", kind, ok, err)
+		}
+	})
+}
+
+// TestTMDBClient_IndependentConfigs - 複数設定のクライアントが独立していることのテスト
+func TestTMDBClient_IndependentConfigs(t *testing.T) {
+	clientA := NewTMDBClient(&TMDBConfig{APIKey: "key-a", Timeout: 3 * time.Second})
+	clientB := NewTMDBClient(&TMDBConfig{APIKey: "key-b"})
+
+	if clientA.Config().APIKey == clientB.Config().APIKey {
+		t.Error("Expected independent API keys")
+	}
+	if clientA.httpClient.Timeout != 3*time.Second {
+		t.Errorf("Expected timeout 3s, got %v", clientA.httpClient.Timeout)
+	}
+	if clientA.httpClient.Transport != clientB.httpClient.Transport {
+		t.Error("Expected clients to share the pooled Transport")
+	}
+	if clientB.Config().BaseURL != BaseURL {
+		t.Errorf("Expected default BaseURL '%s', got '%s'", BaseURL, clientB.Config().BaseURL)
+	}
+}
+
+// TestDefaultTMDBConfig_Env - 環境変数からデフォルト設定を読み込むことのテスト
+func TestDefaultTMDBConfig_Env(t *testing.T) {
+	t.Setenv("TMDB_BASE_URL", "")
+	t.Setenv("TMDB_LANGUAGE", "ja-JP")
+	t.Setenv("TMDB_REGION", "JP")
+	t.Setenv("TMDB_SEARCH_INCLUDE_ADULT", "true")
+
+	config := DefaultTMDBConfig()
+	if config.BaseURL != BaseURL {
+		t.Errorf("Expected default BaseURL '%s', got '%s'", BaseURL, config.BaseURL)
+	}
+	if config.Language != "ja-JP" || config.Region != "JP" || !config.SearchIncludeAdult {
+		t.Errorf("Unexpected config from env: language=%s region=%s includeAdult=%v", config.Language, config.Region, config.SearchIncludeAdult)
+	}
+
+	// ローカルのフェイクTMDBサーバーを指す（末尾の/は取り除く）
+	t.Setenv("TMDB_BASE_URL", "http://localhost:9090/3/")
+	if got := DefaultTMDBConfig().BaseURL; got != "http://localhost:9090/3" {
+		t.Errorf("Expected BaseURL from TMDB_BASE_URL, got '%s'", got)
+	}
+}
+
+// TestTMDBClient_ContextCancel - 呼び出し元のキャンセルで上流リクエストが中断されることのテスト
+func TestTMDBClient_ContextCancel(t *testing.T) {
+	upstreamCanceled := make(chan struct{})
+	client := newTestTMDBClient(t, func(w http.ResponseWriter, r *http.Request) {
+		// クライアントが切断するまでレスポンスを返さない
+		<-r.Context().Done()
+		close(upstreamCanceled)
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		cancel()
+	}()
+
+	start := time.Now()
+	_, err := client.SearchMovies(ctx, "avengers", 1, SearchOptions{})
+	if err == nil {
+		t.Fatal("Expected error when context is canceled")
+	}
+	if !errors.Is(err, context.Canceled) || !errors.Is(err, ErrRequestCanceled) {
+		t.Errorf("Expected context.Canceled wrapped in ErrRequestCanceled, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Expected request to abort quickly, took %v", elapsed)
+	}
+
+	select {
+	case <-upstreamCanceled:
+	case <-time.After(time.Second):
+		t.Error("Expected upstream request to be canceled")
+	}
+}
+
+// TestTMDBClient_ContextDeadline - 呼び出し元の期限切れはクライアントの切断ではなくタイムアウトとして扱うことのテスト
+func TestTMDBClient_ContextDeadline(t *testing.T) {
+	client := newTestTMDBClient(t, func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err := client.SearchMovies(ctx, "avengers", 1, SearchOptions{})
+	if kind, ok := UpstreamErrorKindOf(err); !ok || kind != UpstreamTimeout {
+		t.Errorf("Expected UpstreamTimeout, got %v", err)
+	}
+	if errors.Is(err, ErrRequestCanceled) {
+		t.Errorf("Expected deadline not to be reported as cancellation, got %v", err)
+	}
+}
+
+// TestTMDBClient_EndpointTimeout - エンドポイント毎のタイムアウトのテスト
+func TestTMDBClient_EndpointTimeout(t *testing.T) {
+	client := newTestTMDBClient(t, func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+	})
+	client.Config().EndpointTimeouts = map[string]time.Duration{
+		EndpointGenres: 20 * time.Millisecond,
+	}
+
+	start := time.Now()
+	_, err := client.GetGenres(context.Background())
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected context.DeadlineExceeded, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("Expected endpoint timeout to apply, took %v", elapsed)
+	}
+}
+
+// TestTMDBClient_Cache - キャッシュ対象のエンドポイントが2回目以降TMDBを呼ばないことのテスト
+func TestTMDBClient_Cache(t *testing.T) {
+	var requests int
+	client := newTestTMDBClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Write([]byte(`{"genres":[{"id":28,"name":"Action"}]}`))
+	})
+	client.Config().CacheTTLs = map[string]time.Duration{EndpointGenres: time.Hour}
+	client.cache = NewResponseCache(10, 0)
+
+	for i := 0; i < 3; i++ {
+		resp, err := client.GetGenres(context.Background())
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(resp.Genres) != 1 || resp.Genres[0].Name != "Action" {
+			t.Errorf("Unexpected response: %+v", resp)
+		}
+	}
+
+	if requests != 1 {
+		t.Errorf("Expected 1 upstream request, got %d", requests)
+	}
+	stats := client.Cache().Stats()
+	if stats.Hits != 2 || stats.Misses != 1 {
+		t.Errorf("Unexpected cache stats: %+v", stats)
+	}
+
+	// TTL未設定のエンドポイントはキャッシュしない
+	if _, err := client.GetMovieDetail(context.Background(), 1); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if _, err := client.GetMovieDetail(context.Background(), 1); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if requests != 3 {
+		t.Errorf("Expected uncached endpoint to hit upstream each time, got %d requests", requests)
+	}
+}
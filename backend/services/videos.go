@@ -0,0 +1,116 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"go-movie-explorer/models"
+)
+
+// 動画の種類（TMDBの値）
+const (
+	videoTypeTrailer = "Trailer"
+	videoTypeTeaser  = "Teaser"
+	videoTypeClip    = "Clip"
+)
+
+// videoFallbackLanguage はリクエストの言語の予告編が無い場合に使う言語
+const videoFallbackLanguage = "en"
+
+// --- 動画取得（/movie/{id}/videos）---
+func (c *TMDBClient) GetMovieVideos(ctx context.Context, id int) (*models.MovieVideos, error) {
+	// languageを指定するとその言語の動画しか返らないため、英語と言語なしの動画も含める
+	lang := baseLanguage(c.locale(ctx).Language)
+	params := url.Values{"include_video_language": {videoLanguages(lang)}}
+
+	var tmdbResp models.TmdbVideosResponse
+	if err := c.get(ctx, EndpointVideos, fmt.Sprintf("/movie/%d/videos", id), params, &tmdbResp); err != nil {
+		return nil, err
+	}
+
+	videos := []models.Video{}
+	for _, v := range tmdbResp.Results {
+		switch v.Type {
+		case videoTypeTrailer, videoTypeTeaser, videoTypeClip:
+			v.URL = videoURL(v.Site, v.Key)
+			videos = append(videos, v)
+		}
+	}
+
+	return &models.MovieVideos{
+		MovieID:     id,
+		BestTrailer: bestTrailer(videos, lang),
+		Results:     videos,
+	}, nil
+}
+
+// bestTrailer は予告編を選ぶ
+// 優先順: 言語（リクエストの言語 > 英語 > その他）> 種類（Trailer > Teaser）> 公式 > 公開日の新しい順
+func bestTrailer(videos []models.Video, lang string) *models.Video {
+	var candidates []models.Video
+	for _, v := range videos {
+		if (v.Type == videoTypeTrailer || v.Type == videoTypeTeaser) && v.URL != "" {
+			candidates = append(candidates, v)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	// 言語が未指定の場合は言語の無い動画を優先せず英語を優先する
+	languageRank := func(v models.Video) int {
+		switch {
+		case lang != "" && v.Language == lang:
+			return 0
+		case v.Language == videoFallbackLanguage:
+			return 1
+		}
+		return 2
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		if ra, rb := languageRank(a), languageRank(b); ra != rb {
+			return ra < rb
+		}
+		if a.Type != b.Type {
+			return a.Type == videoTypeTrailer
+		}
+		if a.Official != b.Official {
+			return a.Official
+		}
+		return a.PublishedAt > b.PublishedAt
+	})
+	best := candidates[0]
+	return &best
+}
+
+// videoLanguages はinclude_video_languageの値を作成（例: ja,en,null）
+func videoLanguages(lang string) string {
+	if lang == "" || lang == videoFallbackLanguage {
+		return videoFallbackLanguage + ",null"
+	}
+	return lang + "," + videoFallbackLanguage + ",null"
+}
+
+// videoURL は動画サイトとキーから再生ページのURLを作成（未対応のサイトは空文字）
+func videoURL(site, key string) string {
+	if key == "" {
+		return ""
+	}
+	switch site {
+	case "YouTube":
+		return "https://www.youtube.com/watch?v=" + url.QueryEscape(key)
+	case "Vimeo":
+		return "https://vimeo.com/" + url.PathEscape(key)
+	}
+	return ""
+}
+
+// baseLanguage はja-JPのような言語タグから言語部分（ja）を返す
+func baseLanguage(language string) string {
+	lang, _, _ := strings.Cut(language, "-")
+	return lang
+}
@@ -0,0 +1,102 @@
+package services
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"go-movie-explorer/models"
+)
+
+// TestTMDBClient_GetMovieVideos - 動画の絞り込みと言語を考慮したリクエストのテスト
+func TestTMDBClient_GetMovieVideos(t *testing.T) {
+	var gotPath, gotVideoLanguages, gotLanguage string
+	client := newTestTMDBClient(t, func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotVideoLanguages = r.URL.Query().Get("include_video_language")
+		gotLanguage = r.URL.Query().Get("language")
+		w.Write([]byte(`{"id": 550, "results": [
+			{"id": "1", "key": "en-trailer", "site": "YouTube", "type": "Trailer", "iso_639_1": "en", "official": true, "published_at": "2020-01-01T00:00:00.000Z"},
+			{"id": "2", "key": "ja-teaser", "site": "YouTube", "type": "Teaser", "iso_639_1": "ja", "official": true, "published_at": "2020-01-01T00:00:00.000Z"},
+			{"id": "3", "key": "ja-trailer", "site": "YouTube", "type": "Trailer", "iso_639_1": "ja", "official": false, "published_at": "2020-02-01T00:00:00.000Z"},
+			{"id": "4", "key": "making-of", "site": "YouTube", "type": "Behind the Scenes", "iso_639_1": "ja"},
+			{"id": "5", "key": "clip", "site": "YouTube", "type": "Clip", "iso_639_1": "en"}
+		]}`))
+	})
+
+	ctx := WithLocale(context.Background(), Locale{Language: "ja-JP"})
+	videos, err := client.GetMovieVideos(ctx, 550)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if gotPath != "/movie/550/videos" || gotLanguage != "ja-JP" || gotVideoLanguages != "ja,en,null" {
+		t.Errorf("Unexpected request: path=%s language=%s include_video_language=%s", gotPath, gotLanguage, gotVideoLanguages)
+	}
+	if len(videos.Results) != 4 {
+		t.Errorf("Expected trailers, teasers and clips only, got %+v", videos.Results)
+	}
+	if videos.BestTrailer == nil || videos.BestTrailer.Key != "ja-trailer" {
+		t.Errorf("Expected Japanese trailer as best trailer, got %+v", videos.BestTrailer)
+	}
+	if videos.BestTrailer.URL != "https://www.youtube.com/watch?v=ja-trailer" {
+		t.Errorf("Unexpected trailer URL: %s", videos.BestTrailer.URL)
+	}
+}
+
+// TestBestTrailer - 予告編の優先順位のテスト
+func TestBestTrailer(t *testing.T) {
+	video := func(key, lang, typ string, official bool, published string) models.Video {
+		return models.Video{Key: key, Language: lang, Type: typ, Official: official, PublishedAt: published, URL: "https://example.com/" + key}
+	}
+
+	tests := []struct {
+		name     string
+		lang     string
+		videos   []models.Video
+		expected string
+	}{
+		{
+			name:     "リクエストの言語が無い場合は英語",
+			lang:     "ja",
+			videos:   []models.Video{video("fr", "fr", "Trailer", true, ""), video("en", "en", "Trailer", true, "")},
+			expected: "en",
+		},
+		{
+			name:     "言語が未指定の場合は言語の無い動画より英語",
+			lang:     "",
+			videos:   []models.Video{video("none", "", "Trailer", true, ""), video("en", "en", "Trailer", true, "")},
+			expected: "en",
+		},
+		{
+			name:     "同じ言語ならティーザーより予告編",
+			lang:     "en",
+			videos:   []models.Video{video("teaser", "en", "Teaser", true, ""), video("trailer", "en", "Trailer", false, "")},
+			expected: "trailer",
+		},
+		{
+			name:     "公式を優先し、同条件なら新しい方",
+			lang:     "en",
+			videos:   []models.Video{video("old", "en", "Trailer", true, "2019"), video("fan", "en", "Trailer", false, "2021"), video("new", "en", "Trailer", true, "2020")},
+			expected: "new",
+		},
+		{
+			name:     "クリップのみの場合は無し",
+			lang:     "en",
+			videos:   []models.Video{video("clip", "en", "Clip", true, "")},
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			best := bestTrailer(tt.videos, tt.lang)
+			got := ""
+			if best != nil {
+				got = best.Key
+			}
+			if got != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
@@ -1,4 +1,4 @@
-import type { MoviesResponse, MovieDetail, GenreMovieListResponse, APIError, GenreListResponse } from '@/types/movie';
+import type { MoviesResponse, DatedMoviesResponse, MovieDetail, GenreMovieListResponse, APIError, GenreListResponse, Credits, MovieVideos, MovieWatchProviders, MovieReleases, Collection, Person, PersonMoviesResponse, PersonMoviesSort, PeopleSearchResponse, MovieKeywords, KeywordSearchResponse, SearchMediaType, SearchResponse } from '@/types/movie';
 
 const API_BASE_URL = import.meta.env.VITE_API_BASE_URL || 'http://localhost:8080';
 
@@ -6,6 +6,21 @@ if (!import.meta.env.VITE_API_BASE_URL) {
   console.warn('VITE_API_BASE_URLが環境変数に設定されていません。デフォルト値を使用します:', API_BASE_URL);
 }
 
+// HTTPステータスとエラーコードを保持するAPIエラー（404の判定などに使用）
+export class ApiRequestError extends Error {
+  status: number;
+  code?: string;
+  requestId?: string;
+
+  constructor(message: string, status: number, code?: string, requestId?: string) {
+    super(message);
+    this.name = 'ApiRequestError';
+    this.status = status;
+    this.code = code;
+    this.requestId = requestId;
+  }
+}
+
 const request = async <T>(
   endpoint: string,
   options: RequestInit = {}
@@ -22,8 +37,13 @@ const request = async <T>(
     });
 
     if (!response.ok) {
-      const errorData: APIError = await response.json();
-      throw new Error(errorData.message || `HTTP ${response.status}`);
+      const errorData: APIError | null = await response.json().catch(() => null);
+      throw new ApiRequestError(
+        errorData?.message || `HTTP ${response.status}`,
+        response.status,
+        errorData?.code,
+        errorData?.requestId ?? response.headers.get('X-Request-ID') ?? undefined
+      );
     }
 
     return await response.json();
@@ -35,25 +55,187 @@ const request = async <T>(
   }
 };
 
-export const getMovies = (page: number = 1): Promise<MoviesResponse> => {
-  return request<MoviesResponse>(`/api/movies?page=${page}`);
+// IDの絞り込み（all: 全て含む、any: いずれかを含む）
+export interface IdFilter {
+  ids: number[];
+  match?: 'all' | 'any';
+}
+
+const idFilterValue = ({ ids, match = 'all' }: IdFilter): string => ids.join(match === 'any' ? '|' : ',');
+
+// 映画一覧の絞り込み条件（配信サービスはregionまたは言語から決まる地域で判定）
+export interface MoviesOptions {
+  sortBy?: string; // 例: vote_average.desc
+  year?: number;
+  releaseDateFrom?: string; // YYYY-MM-DD
+  releaseDateTo?: string;
+  voteAverageMin?: number;
+  voteCountMin?: number;
+  runtimeMin?: number;
+  runtimeMax?: number;
+  originalLanguage?: string;
+  genres?: IdFilter;
+  excludeGenres?: number[];
+  keywords?: IdFilter;
+  companies?: IdFilter;
+  watchProviders?: IdFilter;
+  monetization?: ('flatrate' | 'rent' | 'buy' | 'free' | 'ads')[];
+  region?: string;
+}
+
+export const getMovies = (page: number = 1, options: MoviesOptions = {}): Promise<MoviesResponse> => {
+  const params = new URLSearchParams({ page: String(page) });
+  const set = (key: string, value: string | number | undefined) => {
+    if (value !== undefined && value !== '') params.set(key, String(value));
+  };
+  set('sort_by', options.sortBy);
+  set('year', options.year);
+  set('release_date_from', options.releaseDateFrom);
+  set('release_date_to', options.releaseDateTo);
+  set('vote_average_min', options.voteAverageMin);
+  set('vote_count_min', options.voteCountMin);
+  set('runtime_min', options.runtimeMin);
+  set('runtime_max', options.runtimeMax);
+  set('original_language', options.originalLanguage);
+  if (options.genres?.ids.length) set('genre_id', idFilterValue(options.genres));
+  if (options.excludeGenres?.length) set('exclude_genre', options.excludeGenres.join(','));
+  if (options.keywords?.ids.length) set('keyword_id', idFilterValue(options.keywords));
+  if (options.companies?.ids.length) set('company_id', idFilterValue(options.companies));
+  if (options.watchProviders?.ids.length) set('watch_provider', idFilterValue(options.watchProviders));
+  if (options.monetization?.length) set('monetization', options.monetization.join(','));
+  set('region', options.region);
+  return request<MoviesResponse>(`/api/movies?${params.toString()}`);
 };
 
 export const getPopularMovies = (page: number = 1): Promise<MoviesResponse> => {
   return request<MoviesResponse>(`/api/movies/popular?page=${page}`);
 };
 
-export const getMovieDetail = (id: number): Promise<MovieDetail> => {
-  return request<MovieDetail>(`/api/movie/${id}`);
+const regionQuery = (page: number, region?: string): string => {
+  const params = new URLSearchParams({ page: String(page) });
+  if (region) params.set('region', region);
+  return params.toString();
+};
+
+export const getNowPlayingMovies = (page: number = 1, region?: string): Promise<DatedMoviesResponse> => {
+  return request<DatedMoviesResponse>(`/api/movies/now_playing?${regionQuery(page, region)}`);
+};
+
+export const getUpcomingMovies = (page: number = 1, region?: string): Promise<DatedMoviesResponse> => {
+  return request<DatedMoviesResponse>(`/api/movies/upcoming?${regionQuery(page, region)}`);
+};
+
+export const getTopRatedMovies = (page: number = 1, region?: string): Promise<DatedMoviesResponse> => {
+  return request<DatedMoviesResponse>(`/api/movies/top_rated?${regionQuery(page, region)}`);
+};
+
+export const getTrendingMovies = (window: 'day' | 'week' = 'day', page: number = 1): Promise<MoviesResponse> => {
+  return request<MoviesResponse>(`/api/movies/trending?window=${window}&page=${page}`);
+};
+
+export const getMovieDetail = (id: number, include: ('credits' | 'videos' | 'collection')[] = []): Promise<MovieDetail> => {
+  const query = include.length > 0 ? `?include=${include.join(',')}` : '';
+  return request<MovieDetail>(`/api/movie/${id}${query}`);
+};
+
+export const getMovieCredits = (id: number): Promise<Credits> => {
+  return request<Credits>(`/api/movie/${id}/credits`);
+};
+
+export const getMovieVideos = (id: number): Promise<MovieVideos> => {
+  return request<MovieVideos>(`/api/movie/${id}/videos`);
+};
+
+export const getMovieWatchProviders = (id: number, region?: string): Promise<MovieWatchProviders> => {
+  const query = region ? `?region=${encodeURIComponent(region)}` : '';
+  return request<MovieWatchProviders>(`/api/movie/${id}/providers${query}`);
+};
+
+export const getMovieReleases = (id: number, region?: string): Promise<MovieReleases> => {
+  const query = region ? `?region=${encodeURIComponent(region)}` : '';
+  return request<MovieReleases>(`/api/movie/${id}/releases${query}`);
 };
 
-export const searchMovies = (query: string, page: number = 1): Promise<MoviesResponse> => {
+export const getCollection = (id: number): Promise<Collection> => {
+  return request<Collection>(`/api/collection/${id}`);
+};
+
+// おすすめ・類似作品の絞り込み条件
+export interface RelatedMoviesOptions {
+  page?: number;
+  excludeIds?: number[]; // 視聴済みの作品など
+  includeAdult?: boolean;
+}
+
+const relatedMoviesQuery = ({ page = 1, excludeIds = [], includeAdult = false }: RelatedMoviesOptions): string => {
+  const params = new URLSearchParams({ page: String(page) });
+  if (excludeIds.length > 0) params.set('exclude_ids', excludeIds.join(','));
+  if (includeAdult) params.set('include_adult', 'true');
+  return params.toString();
+};
+
+export const getMovieRecommendations = (id: number, options: RelatedMoviesOptions = {}): Promise<MoviesResponse> => {
+  return request<MoviesResponse>(`/api/movie/${id}/recommendations?${relatedMoviesQuery(options)}`);
+};
+
+export const getSimilarMovies = (id: number, options: RelatedMoviesOptions = {}): Promise<MoviesResponse> => {
+  return request<MoviesResponse>(`/api/movie/${id}/similar?${relatedMoviesQuery(options)}`);
+};
+
+export const getPerson = (id: number): Promise<Person> => {
+  return request<Person>(`/api/person/${id}`);
+};
+
+export const getPersonMovies = (id: number, sort: PersonMoviesSort = 'release_date.desc'): Promise<PersonMoviesResponse> => {
+  return request<PersonMoviesResponse>(`/api/person/${id}/movies?sort=${sort}`);
+};
+
+export const searchPeople = (query: string, page: number = 1): Promise<PeopleSearchResponse> => {
   const encodedQuery = encodeURIComponent(query);
-  return request<MoviesResponse>(`/api/movies/search?query=${encodedQuery}&page=${page}`);
+  return request<PeopleSearchResponse>(`/api/people/search?query=${encodedQuery}&page=${page}`);
+};
+
+export const getMovieKeywords = (id: number): Promise<MovieKeywords> => {
+  return request<MovieKeywords>(`/api/movie/${id}/keywords`);
+};
+
+export const searchKeywords = (query: string, page: number = 1): Promise<KeywordSearchResponse> => {
+  const encodedQuery = encodeURIComponent(query);
+  return request<KeywordSearchResponse>(`/api/keywords/search?query=${encodedQuery}&page=${page}`);
+};
+
+export const getKeywordMovies = (id: number, page: number = 1): Promise<MoviesResponse> => {
+  return request<MoviesResponse>(`/api/keywords/${id}/movies?page=${page}`);
+};
+
+export const search = (query: string, page: number = 1, mediaType?: SearchMediaType): Promise<SearchResponse> => {
+  const params = new URLSearchParams({ query, page: String(page) });
+  if (mediaType) params.set('media_type', mediaType);
+  return request<SearchResponse>(`/api/search?${params.toString()}`);
+};
+
+// 映画検索の絞り込み条件（includeAdult未指定の場合はサーバーの設定に従う）
+export interface SearchMoviesOptions {
+  year?: number;
+  primaryReleaseYear?: number;
+  includeAdult?: boolean;
+  region?: string;
+}
+
+export const searchMovies = (query: string, page: number = 1, options: SearchMoviesOptions = {}): Promise<MoviesResponse> => {
+  const params = new URLSearchParams({ query, page: String(page) });
+  if (options.year) params.set('year', String(options.year));
+  if (options.primaryReleaseYear) params.set('primary_release_year', String(options.primaryReleaseYear));
+  if (options.includeAdult !== undefined) params.set('include_adult', String(options.includeAdult));
+  if (options.region) params.set('region', options.region);
+  return request<MoviesResponse>(`/api/movies/search?${params.toString()}`);
 };
 
-export const getMoviesByGenre = (genreId: number, page: number = 1): Promise<GenreMovieListResponse> => {
-  return request<GenreMovieListResponse>(`/api/movies/genre?genre_id=${genreId}&page=${page}`);
+export const getMoviesByGenre = (genres: number | IdFilter, page: number = 1, excludeGenres: number[] = []): Promise<GenreMovieListResponse> => {
+  const filter = typeof genres === 'number' ? { ids: [genres] } : genres;
+  const params = new URLSearchParams({ genre_id: idFilterValue(filter), page: String(page) });
+  if (excludeGenres.length) params.set('exclude_genre', excludeGenres.join(','));
+  return request<GenreMovieListResponse>(`/api/movies/genre?${params.toString()}`);
 };
 
 export const getGenres = (): Promise<{ genres: { id: number; name: string }[] }> => {
@@ -1,23 +1,28 @@
 import { useEffect, useState } from 'react';
-import { getMovieDetail } from '@/api';
+import { getMovieDetail, ApiRequestError } from '@/api';
 import type { MovieDetail } from '@/types/movie';
 
 export function useMovieDetail(id?: string) {
   const [movie, setMovie] = useState<MovieDetail | null>(null);
   const [loading, setLoading] = useState(true);
   const [error, setError] = useState<string | null>(null);
+  const [notFound, setNotFound] = useState(false);
 
   useEffect(() => {
     if (!id) return;
     setLoading(true);
-    getMovieDetail(Number(id))
+    getMovieDetail(Number(id), ['credits', 'collection'])
       .then((detail) => {
         setMovie(detail);
         setError(null);
+        setNotFound(false);
+      })
+      .catch((e) => {
+        setError(e.message);
+        setNotFound(e instanceof ApiRequestError && e.status === 404);
       })
-      .catch((e) => setError(e.message))
       .finally(() => setLoading(false));
   }, [id]);
 
-  return { movie, loading, error };
+  return { movie, loading, error, notFound };
 }
\ No newline at end of file
@@ -1,11 +1,12 @@
 import { useParams } from "react-router-dom";
 import { useMovieDetail } from "@/hooks/useMovieDetail";
 import { useNavigate } from "react-router-dom";
+import { NotFoundPage } from "@/pages/error/NotFoundPage";
 
 export function MovieDetailPage() {
   const { id } = useParams<{ id: string }>();
   const navigate = useNavigate();
-  const { movie, loading, error } = useMovieDetail(id);
+  const { movie, loading, error, notFound } = useMovieDetail(id);
 
   if (loading) {
     return (
@@ -14,6 +15,9 @@ export function MovieDetailPage() {
       </div>
     );
   }
+  if (notFound) {
+    return <NotFoundPage />;
+  }
   if (error) {
     return (
       <div className="min-h-screen flex items-center justify-center">
@@ -41,6 +45,9 @@ export function MovieDetailPage() {
         <h1 className="text-3xl font-bold text-indigo-600 mb-6 text-center">
           🎦 {movie.title}
         </h1>
+        {movie.tagline && (
+          <p className="-mt-4 mb-6 text-center italic text-gray-500">{movie.tagline}</p>
+        )}
         <div className="flex flex-col md:flex-row gap-8 items-center">
           {movie.poster_path && (
             <img
@@ -58,6 +65,38 @@ export function MovieDetailPage() {
               <span className="font-semibold">公開日:</span>{" "}
               {movie.release_date}
             </p>
+            {movie.belongs_to_collection && (
+              <p className="mb-2">
+                <span className="font-semibold">シリーズ:</span>{" "}
+                {movie.belongs_to_collection.name}
+                {(movie.belongs_to_collection.part ?? 0) > 0 &&
+                  `（${movie.belongs_to_collection.part}作目 / 全${movie.belongs_to_collection.total_parts}作）`}
+              </p>
+            )}
+            {movie.runtime > 0 && (
+              <p className="mb-2">
+                <span className="font-semibold">上映時間:</span>{" "}
+                {movie.runtime}分
+              </p>
+            )}
+            {movie.certification && (
+              <p className="mb-2">
+                <span className="font-semibold">年齢制限:</span>{" "}
+                {movie.certification}
+              </p>
+            )}
+            {movie.credits && movie.credits.directors.length > 0 && (
+              <p className="mb-2">
+                <span className="font-semibold">監督:</span>{" "}
+                {movie.credits.directors.map((d) => d.name).join(", ")}
+              </p>
+            )}
+            {movie.credits && movie.credits.writers.length > 0 && (
+              <p className="mb-2">
+                <span className="font-semibold">脚本:</span>{" "}
+                {movie.credits.writers.map((w) => w.name).join(", ")}
+              </p>
+            )}
             <p className="mb-2">
               <span className="font-semibold">ジャンル:</span>{" "}
               {movie.genres.map((g) => g.name).join(", ")}
@@ -73,6 +112,16 @@ export function MovieDetailPage() {
               <span className="font-semibold">予算:</span>{" "}
               {movie.budget ? `${movie.budget.toLocaleString()} USD` : "不明"}
             </p>
+            <p className="mb-2">
+              <span className="font-semibold">興行収入:</span>{" "}
+              {movie.revenue ? `${movie.revenue.toLocaleString()} USD` : "不明"}
+            </p>
+            {movie.production_companies.length > 0 && (
+              <p className="mb-2">
+                <span className="font-semibold">制作:</span>{" "}
+                {movie.production_companies.map((c) => c.name).join(", ")}
+              </p>
+            )}
             <p className="mb-2">
               <span className="font-semibold">IMDB:</span>{" "}
               {movie.imdb_id ? (
@@ -92,6 +141,31 @@ export function MovieDetailPage() {
               <span className="font-semibold">概要:</span>{" "}
               {movie.overview || "説明なし"}
             </p>
+            {movie.credits && movie.credits.cast.length > 0 && (
+              <div className="mb-4">
+                <span className="font-semibold">出演:</span>
+                <ul className="mt-2 grid grid-cols-2 gap-2">
+                  {movie.credits.cast.slice(0, 10).map((c) => (
+                    <li key={c.credit_id} className="text-sm">
+                      {c.name}
+                      {c.character && (
+                        <span className="text-gray-500"> … {c.character}</span>
+                      )}
+                    </li>
+                  ))}
+                </ul>
+              </div>
+            )}
+            {movie.trailer?.url && (
+              <a
+                href={movie.trailer.url}
+                target="_blank"
+                rel="noopener noreferrer"
+                className="inline-block mb-4 mr-4 bg-red-600 hover:bg-red-700 text-white px-4 py-2 rounded-lg transition-colors"
+              >
+                ▶ 予告編を見る
+              </a>
+            )}
             {movie.homepage && (
               <a
                 href={movie.homepage}
@@ -10,6 +10,14 @@ export interface Movie {
   vote_count?: number;
 }
 
+// 上映中・公開予定・高評価の一覧（top_ratedにはdatesが無い）
+export interface DatedMoviesResponse extends MoviesResponse {
+  dates?: {
+    minimum: string;
+    maximum: string;
+  };
+}
+
 export interface MovieDetail extends Movie {
   original_title: string;
   backdrop_path: string;
@@ -19,6 +27,147 @@ export interface MovieDetail extends Movie {
   budget: number;
   origin_country: string[];
   original_language: string;
+  adult: boolean;
+  runtime: number; // 上映時間（分）。不明の場合は0
+  revenue: number; // 興行収入（USD）。不明の場合は0
+  tagline: string;
+  status: 'Rumored' | 'Planned' | 'In Production' | 'Post Production' | 'Released' | 'Canceled';
+  vote_count: number;
+  spoken_languages: SpokenLanguage[];
+  production_companies: ProductionCompany[];
+  production_countries: ProductionCountry[];
+  belongs_to_collection: MovieCollection | null; // シリーズでない場合はnull
+  certification: string; // リクエスト地域の年齢制限（無い場合は空文字）
+  trailer: Video | null; // リクエストの言語を優先して選んだ予告編
+  credits?: Credits; // include=credits指定時のみ
+  videos?: Video[]; // include=videos指定時のみ
+}
+
+export interface SpokenLanguage {
+  iso_639_1: string;
+  name: string;
+  english_name: string;
+}
+
+export interface ProductionCompany {
+  id: number;
+  name: string;
+  logo_path: string;
+  origin_country: string;
+}
+
+export interface ProductionCountry {
+  iso_3166_1: string;
+  name: string;
+}
+
+export interface BelongsToCollection {
+  id: number;
+  name: string;
+  poster_path: string;
+  backdrop_path: string;
+}
+
+// 映画詳細に含めるシリーズ情報
+export interface MovieCollection extends BelongsToCollection {
+  part?: number; // シリーズ中の何作目か（1始まり。include=collection指定時のみ）
+  total_parts?: number;
+}
+
+// コレクション（/api/collection/{id}）
+export interface Collection {
+  id: number;
+  name: string;
+  overview: string;
+  poster_path: string;
+  backdrop_path: string;
+  parts: Movie[]; // 公開日順
+}
+
+export interface Video {
+  id: string;
+  name: string;
+  key: string;
+  site: string;
+  type: 'Trailer' | 'Teaser' | 'Clip';
+  size: number;
+  iso_639_1: string;
+  iso_3166_1: string;
+  official: boolean;
+  published_at: string;
+  url?: string;
+}
+
+export interface MovieVideos {
+  movie_id: number;
+  best_trailer: Video | null;
+  results: Video[];
+}
+
+// 公開日・年齢制限（/api/movie/{id}/releases）
+export interface Release {
+  type: 'premiere' | 'theatrical_limited' | 'theatrical' | 'digital' | 'physical' | 'tv';
+  release_date: string;
+  certification: string;
+  descriptors: string[];
+  iso_639_1: string;
+  note: string;
+}
+
+export interface CountryReleases {
+  iso_3166_1: string;
+  certification: string;
+  releases: Release[];
+}
+
+export interface MovieReleases {
+  movie_id: number;
+  region: string;
+  certification: string;
+  countries: CountryReleases[];
+}
+
+// 配信サービス（/api/movie/{id}/providers）
+export interface WatchProvider {
+  provider_id: number;
+  provider_name: string;
+  logo_path: string;
+  display_priority: number;
+}
+
+export interface MovieWatchProviders {
+  movie_id: number;
+  region: string;
+  link: string;
+  flatrate: WatchProvider[]; // 見放題
+  rent: WatchProvider[];
+  buy: WatchProvider[];
+}
+
+export interface CastMember {
+  id: number;
+  name: string;
+  character: string;
+  order: number;
+  profile_path: string;
+  credit_id: string;
+}
+
+export interface CrewMember {
+  id: number;
+  name: string;
+  job: string;
+  department: string;
+  profile_path: string;
+  credit_id: string;
+}
+
+export interface Credits {
+  movie_id: number;
+  cast: CastMember[];
+  crew: Record<string, CrewMember[]>; // 部署名をキーにしたスタッフ一覧
+  directors: CrewMember[];
+  writers: CrewMember[];
 }
 
 export interface Genre {
@@ -38,7 +187,11 @@ export interface MoviesResponse {
 }
 
 export interface GenreMovieListResponse {
-  genre_id: number;
+  genre_id: number; // 最初に指定したジャンルID
+  genre_ids: number[];
+  match: 'all' | 'any';
+  genres: Genre[];
+  excluded_genres: Genre[];
   page: number;
   per_page: number;
   total_pages: number;
@@ -46,7 +199,123 @@ export interface GenreMovieListResponse {
   results: Movie[];
 }
 
+// エラーレスポンス（application/problem+json, RFC 7807）
 export interface APIError {
+  type: string;
+  title: string;
+  status: number;
+  detail: string;
+  instance?: string;
   statusCode: number;
   message: string;
-}
\ No newline at end of file
+  code: string;
+  requestId?: string;
+}
+
+export interface ProfileImage {
+  file_path: string;
+  width: number;
+  height: number;
+  aspect_ratio: number;
+}
+
+export interface Person {
+  id: number;
+  name: string;
+  also_known_as: string[];
+  biography: string;
+  birthday: string; // 不明な場合は空文字
+  deathday: string; // 存命・不明の場合は空文字
+  place_of_birth: string;
+  gender: number;
+  known_for_department: string;
+  homepage: string;
+  imdb_id: string;
+  popularity: number;
+  profile_path: string;
+  profile_images: ProfileImage[];
+}
+
+export interface PersonMovie {
+  id: number;
+  title: string;
+  original_title: string;
+  release_date: string;
+  poster_path: string;
+  vote_average: number;
+  vote_count: number;
+  popularity: number;
+  character?: string;
+  jobs?: string[];
+}
+
+export type PersonMoviesSort = 'release_date.desc' | 'release_date.asc' | 'popularity.desc' | 'popularity.asc';
+
+export interface PersonMoviesResponse {
+  person_id: number;
+  sort: PersonMoviesSort;
+  total_results: number;
+  results: PersonMovie[];
+}
+
+export interface PersonSummary {
+  id: number;
+  name: string;
+  known_for_department: string;
+  profile_path: string;
+  popularity: number;
+  known_for: Movie[];
+}
+
+export interface PeopleSearchResponse {
+  page: number;
+  total_pages: number;
+  total_results: number;
+  results: PersonSummary[];
+}
+
+// キーワード（名前は英語のみ）
+export interface Keyword {
+  id: number;
+  name: string;
+}
+
+export interface MovieKeywords {
+  movie_id: number;
+  keywords: Keyword[];
+}
+
+export interface KeywordSearchResponse {
+  page: number;
+  total_pages: number;
+  total_results: number;
+  results: Keyword[];
+}
+
+// 横断検索（media_typeで種類を判別）
+export type SearchMediaType = 'movie' | 'person' | 'collection' | 'keyword';
+
+export interface SearchResult {
+  media_type: SearchMediaType;
+  id: number;
+  title?: string; // movie
+  name?: string; // person / collection / keyword
+  overview?: string;
+  release_date?: string;
+  poster_path?: string;
+  backdrop_path?: string;
+  profile_path?: string;
+  vote_average?: number;
+  popularity?: number;
+  known_for_department?: string;
+  known_for?: Movie[];
+}
+
+export interface SearchResponse {
+  page: number;
+  total_pages: number;
+  total_results: number;
+  counts: Record<SearchMediaType, number>; // 種類毎の件数（media_type指定時は検索した種類のみ）
+  results: SearchResult[];
+  failed_types?: SearchMediaType[]; // 検索に失敗し0件として扱った種類
+}